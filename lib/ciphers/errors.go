@@ -0,0 +1,48 @@
+package ciphers
+
+import "fmt"
+
+type cipherError struct {
+	Code    byte
+	Message string
+}
+
+func (e *cipherError) Error() string {
+	return fmt.Sprintf("%s (code: %d)", e.Message, e.Code)
+}
+
+// Символ алфавита повторяется.
+func RepeatingCharacterError(character rune) error {
+	return &cipherError{1, fmt.Sprintf("Repeating character in alphabet: %q", character)}
+}
+
+// Символа нет в алфавите.
+func UnknownCharacterError(character rune) error {
+	return &cipherError{2, fmt.Sprintf("Character %q is not in alphabet", character)}
+}
+
+// Длина текста должна быть кратна размеру блока.
+func InvalidTextLengthError(length int, block int) error {
+	return &cipherError{3, fmt.Sprintf("Text length %d is not a multiple of block size %d", length, block)}
+}
+
+// В открытом тексте не нашлось блоков, из которых можно составить обратимую
+// матрицу.
+func NotEnoughPlaintextError(block int) error {
+	return &cipherError{4, fmt.Sprintf("Plaintext does not contain %d blocks forming an invertible matrix", block)}
+}
+
+// Алфавит должен содержать хотя бы два символа.
+func ShortAlphabetError(length int) error {
+	return &cipherError{5, fmt.Sprintf("Alphabet must contain at least 2 characters, got %d", length)}
+}
+
+// Открытый и зашифрованный тексты должны быть одинаковой длины.
+func DifferentTextLengthError(length1 int, length2 int) error {
+	return &cipherError{6, fmt.Sprintf("Text lengths are not the same %d != %d", length1, length2)}
+}
+
+// Размер блока (порядок ключа) должен быть положительным.
+func InvalidBlockSizeError(block int) error {
+	return &cipherError{7, fmt.Sprintf("Block size must be positive, got %d", block)}
+}
@@ -0,0 +1,239 @@
+// Пакет ciphers предоставляет реализацию шифров, основанных на алгоритмах
+// линейной алгебры:
+//   - Шифр Хилла: шифрование, расшифровка и восстановление ключа по известным
+//     парам открытого и зашифрованного текста
+package ciphers
+
+import (
+	"github.com/wadrodrog/math-helper/lib/matrices"
+)
+
+// Стандартный алфавит: заглавные латинские буквы.
+const LatinAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// HillCipher представляет собой шифр Хилла. Текст разбивается на блоки длины
+// n, каждый блок записывается как столбец номеров символов p, а
+// зашифрованный блок вычисляется как c = Kp по модулю размера алфавита.
+type HillCipher struct {
+	alphabet []rune                 // Алфавит
+	indices  map[rune]int64         // Номера символов алфавита
+	key      matrices.ModularMatrix // Ключ K
+	inverse  matrices.ModularMatrix // Обратный ключ K⁻¹
+}
+
+// Возвращает шифр Хилла с заданным алфавитом и ключом.
+//
+// Возвращает ошибку, если в алфавите меньше двух символов или символы
+// повторяются, ключ пустой, не является квадратной матрицей или необратим
+// по модулю размера алфавита.
+func NewHillCipher(alphabet string, key [][]int64) (*HillCipher, error) {
+	letters, indices, err := parseAlphabet(alphabet)
+	if err != nil {
+		return nil, err
+	}
+	// Порядок ключа задаёт размер блока
+	if len(key) == 0 {
+		return nil, InvalidBlockSizeError(0)
+	}
+
+	keyMatrix, err := matrices.NewModularMatrix(key, int64(len(letters)))
+	if err != nil {
+		return nil, err
+	}
+	inverse, err := keyMatrix.Inverse()
+	if err != nil {
+		return nil, err
+	}
+
+	return &HillCipher{letters, indices, keyMatrix, inverse}, nil
+}
+
+// Возвращает шифр Хилла, ключ которого восстановлен по известному открытому
+// тексту и соответствующему ему зашифрованному тексту. Из текстов выбираются
+// n блоков, образующих обратимую матрицу P, после чего ключ находится как
+// K = CP⁻¹.
+//
+// Возвращает ошибку, если n меньше единицы, тексты содержат символы не из
+// алфавита, их длины не равны или не кратны n, или в тексте нет подходящих
+// блоков.
+func RecoverHillCipher(alphabet string, plaintext string, ciphertext string, n int) (*HillCipher, error) {
+	letters, indices, err := parseAlphabet(alphabet)
+	if err != nil {
+		return nil, err
+	}
+	modulus := int64(len(letters))
+	if n < 1 {
+		return nil, InvalidBlockSizeError(n)
+	}
+
+	plainBlocks, err := splitBlocks(plaintext, indices, n)
+	if err != nil {
+		return nil, err
+	}
+	cipherBlocks, err := splitBlocks(ciphertext, indices, n)
+	if err != nil {
+		return nil, err
+	}
+	if len(plainBlocks) != len(cipherBlocks) {
+		return nil, DifferentTextLengthError(len([]rune(plaintext)), len([]rune(ciphertext)))
+	}
+
+	// Перебираем наборы из n блоков, пока не найдём обратимую матрицу P
+	var key [][]int64
+	found := false
+	combinations(len(plainBlocks), n, func(chosen []int) bool {
+		p, _ := matrices.NewModularMatrix(blocksToColumns(plainBlocks, chosen), modulus)
+		inverse, err := p.Inverse()
+		if err != nil {
+			return true
+		}
+		c, _ := matrices.NewModularMatrix(blocksToColumns(cipherBlocks, chosen), modulus)
+		product, _ := c.MultiplyMatrix(inverse)
+		key = product.Elements()
+		found = true
+		return false
+	})
+	if !found {
+		return nil, NotEnoughPlaintextError(n)
+	}
+
+	return NewHillCipher(alphabet, key)
+}
+
+// Возвращает ключ шифра.
+func (c *HillCipher) Key() [][]int64 {
+	return c.key.Elements()
+}
+
+// Возвращает обратный ключ, используемый для расшифровки.
+func (c *HillCipher) InverseKey() [][]int64 {
+	return c.inverse.Elements()
+}
+
+// Шифрует текст. Если длина текста не кратна размеру блока, текст
+// дополняется последним символом алфавита.
+//
+// Возвращает ошибку, если в тексте есть символы не из алфавита.
+func (c *HillCipher) Encrypt(plaintext string) (string, error) {
+	text := []rune(plaintext)
+	n := c.key.Rows()
+	for len(text)%n != 0 {
+		text = append(text, c.alphabet[len(c.alphabet)-1])
+	}
+	return c.apply(string(text), c.key)
+}
+
+// Расшифровывает текст.
+//
+// Возвращает ошибку, если в тексте есть символы не из алфавита или его длина
+// не кратна размеру блока.
+func (c *HillCipher) Decrypt(ciphertext string) (string, error) {
+	return c.apply(ciphertext, c.inverse)
+}
+
+// Умножает каждый блок текста на матрицу по модулю размера алфавита.
+func (c *HillCipher) apply(text string, key matrices.ModularMatrix) (string, error) {
+	blocks, err := splitBlocks(text, c.indices, key.Rows())
+	if err != nil {
+		return "", err
+	}
+
+	result := make([]rune, 0, len(blocks)*key.Rows())
+	for _, block := range blocks {
+		column := make([][]int64, len(block))
+		for i, index := range block {
+			column[i] = []int64{index}
+		}
+		vector, _ := matrices.NewModularMatrix(column, key.Modulus())
+		product, err := key.MultiplyMatrix(vector)
+		if err != nil {
+			return "", err
+		}
+		for i := 0; i < product.Rows(); i++ {
+			result = append(result, c.alphabet[product.At(i, 0)])
+		}
+	}
+	return string(result), nil
+}
+
+// Возвращает символы алфавита и их номера.
+//
+// Возвращает ошибку, если в алфавите меньше двух символов или символы
+// повторяются.
+func parseAlphabet(alphabet string) ([]rune, map[rune]int64, error) {
+	letters := []rune(alphabet)
+	if len(letters) < 2 {
+		return nil, nil, ShortAlphabetError(len(letters))
+	}
+	indices := map[rune]int64{}
+	for i, letter := range letters {
+		if _, ok := indices[letter]; ok {
+			return nil, nil, RepeatingCharacterError(letter)
+		}
+		indices[letter] = int64(i)
+	}
+	return letters, indices, nil
+}
+
+// Разбивает текст на блоки номеров символов длины n.
+//
+// Возвращает ошибку, если в тексте есть символы не из алфавита или его длина
+// не кратна n.
+func splitBlocks(text string, indices map[rune]int64, n int) ([][]int64, error) {
+	letters := []rune(text)
+	if len(letters)%n != 0 {
+		return nil, InvalidTextLengthError(len(letters), n)
+	}
+
+	blocks := make([][]int64, len(letters)/n)
+	for i, letter := range letters {
+		index, ok := indices[letter]
+		if !ok {
+			return nil, UnknownCharacterError(letter)
+		}
+		blocks[i/n] = append(blocks[i/n], index)
+	}
+	return blocks, nil
+}
+
+// Составляет матрицу, столбцами которой являются выбранные блоки.
+func blocksToColumns(blocks [][]int64, chosen []int) [][]int64 {
+	n := len(chosen)
+	columns := make([][]int64, n)
+	for i := range columns {
+		columns[i] = make([]int64, n)
+		for j, block := range chosen {
+			columns[i][j] = blocks[block][i]
+		}
+	}
+	return columns
+}
+
+// Перебирает сочетания из total элементов по k в лексикографическом порядке
+// и вызывает visit для каждого. Перебор прекращается, если visit вернул false.
+func combinations(total int, k int, visit func([]int) bool) {
+	if k > total {
+		return
+	}
+	chosen := make([]int, k)
+	for i := range chosen {
+		chosen[i] = i
+	}
+	for {
+		if !visit(chosen) {
+			return
+		}
+		// Ищем самый правый индекс, который можно увеличить
+		i := k - 1
+		for i >= 0 && chosen[i] == total-k+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		chosen[i]++
+		for j := i + 1; j < k; j++ {
+			chosen[j] = chosen[j-1] + 1
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package ciphers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/wadrodrog/math-helper/lib/matrices"
+)
+
+// Создание шифра Хилла
+func TestNewHillCipher(t *testing.T) {
+	tests := []struct {
+		alphabet string
+		key      [][]int64
+		wantErr  error
+	}{
+		{LatinAlphabet, [][]int64{{3, 3}, {2, 5}}, nil},
+		{"A", [][]int64{{1}}, ShortAlphabetError(1)},
+		{"ABCA", [][]int64{{1}}, RepeatingCharacterError('A')},
+		{LatinAlphabet, [][]int64{}, InvalidBlockSizeError(0)},
+		{LatinAlphabet, nil, InvalidBlockSizeError(0)},
+		{LatinAlphabet, [][]int64{{1, 2}, {3}}, matrices.InvalidMatrixError(2)},
+		{LatinAlphabet, [][]int64{{1, 2, 3}, {4, 5, 6}}, matrices.NotSquareMatrixError()},
+		{LatinAlphabet, [][]int64{{2, 0}, {0, 1}}, matrices.NotInvertibleModularMatrixError(2, 26)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%s %v", tt.alphabet, tt.key)
+		t.Run(testname, func(t *testing.T) {
+			_, err := NewHillCipher(tt.alphabet, tt.key)
+			if err == nil && tt.wantErr != nil {
+				t.Fatalf("got no error, want %q", tt.wantErr)
+			}
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while initializing HillCipher: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Errorf("got %q, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// Шифрование и расшифровка
+func TestHillCipherEncrypt(t *testing.T) {
+	tests := []struct {
+		alphabet   string
+		key        [][]int64
+		plaintext  string
+		ciphertext string
+		decrypted  string
+	}{
+		{LatinAlphabet, [][]int64{{3, 3}, {2, 5}}, "HELP", "HIAT", "HELP"},
+		{LatinAlphabet, [][]int64{{6, 24, 1}, {13, 16, 10}, {20, 17, 15}}, "ACT", "POH", "ACT"},
+		{LatinAlphabet, [][]int64{{6, 24, 1}, {13, 16, 10}, {20, 17, 15}}, "CAT", "FIN", "CAT"},
+		// Текст дополняется последним символом алфавита
+		{LatinAlphabet, [][]int64{{3, 3}, {2, 5}}, "HEL", "HIER", "HELZ"},
+		{"АБВГДЕЁЖЗИЙКЛМНОПРСТУФХЦЧШЩЪЫЬЭЮЯ", [][]int64{{1, 2}, {3, 4}}, "ШИФР", "ЙЛХЯ", "ШИФР"},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v %s", tt.key, tt.plaintext)
+		t.Run(testname, func(t *testing.T) {
+			cipher, err := NewHillCipher(tt.alphabet, tt.key)
+			if err != nil {
+				t.Fatalf("got an error while initializing HillCipher: %v", err)
+			}
+			ciphertext, err := cipher.Encrypt(tt.plaintext)
+			if err != nil {
+				t.Fatalf("got an error while encrypting: %v", err)
+			}
+			if ciphertext != tt.ciphertext {
+				t.Errorf("got ciphertext %q, want %q", ciphertext, tt.ciphertext)
+			}
+			decrypted, err := cipher.Decrypt(ciphertext)
+			if err != nil {
+				t.Fatalf("got an error while decrypting: %v", err)
+			}
+			if decrypted != tt.decrypted {
+				t.Errorf("got plaintext %q, want %q", decrypted, tt.decrypted)
+			}
+		})
+	}
+}
+
+// Ошибки при шифровании и расшифровке
+func TestHillCipherErrors(t *testing.T) {
+	cipher, _ := NewHillCipher(LatinAlphabet, [][]int64{{3, 3}, {2, 5}})
+
+	if _, err := cipher.Encrypt("HELLO!"); err == nil || err.Error() != UnknownCharacterError('!').Error() {
+		t.Errorf("got %v, want %v", err, UnknownCharacterError('!'))
+	}
+	if _, err := cipher.Decrypt("HIA"); err == nil || err.Error() != InvalidTextLengthError(3, 2).Error() {
+		t.Errorf("got %v, want %v", err, InvalidTextLengthError(3, 2))
+	}
+}
+
+// Восстановление ключа по известному открытому тексту
+func TestRecoverHillCipher(t *testing.T) {
+	tests := []struct {
+		plaintext  string
+		ciphertext string
+		n          int
+		want       [][]int64
+		wantErr    error
+	}{
+		{"HELP", "HIAT", 2, [][]int64{{3, 3}, {2, 5}}, nil},
+		// Первые блоки не образуют обратимую матрицу
+		{"AAHELP", "AAHIAT", 2, [][]int64{{3, 3}, {2, 5}}, nil},
+		{"MEETMEATNOON", "QAEQLUBSYRQL", 3, [][]int64{{6, 24, 1}, {13, 16, 10}, {20, 17, 15}}, nil},
+		{"AAAB", "AADF", 2, nil, NotEnoughPlaintextError(2)},
+		{"HELP", "HIA", 2, nil, InvalidTextLengthError(3, 2)},
+		{"HELP", "HIATHI", 2, nil, DifferentTextLengthError(4, 6)},
+		{"HELP", "HIAT", 0, nil, InvalidBlockSizeError(0)},
+		{"HELP", "HIAT", -1, nil, InvalidBlockSizeError(-1)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%s %s n=%d", tt.plaintext, tt.ciphertext, tt.n)
+		t.Run(testname, func(t *testing.T) {
+			cipher, err := RecoverHillCipher(LatinAlphabet, tt.plaintext, tt.ciphertext, tt.n)
+			if err == nil && tt.wantErr != nil {
+				t.Fatalf("got no error, want %q", tt.wantErr)
+			}
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while recovering key: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err == nil && fmt.Sprintf("%v", cipher.Key()) != fmt.Sprintf("%v", tt.want) {
+				t.Errorf("got %v, want %v", cipher.Key(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Пакет explain предоставляет запись пошаговых объяснений алгоритмов:
+//   - Запись шагов на русском и английском языках
+//   - Вывод объяснения простым текстом
+//   - Вывод объяснения в формате Markdown
+package explain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Язык объяснения.
+type Language byte
+
+const (
+	Russian Language = iota
+	English
+)
+
+// Шаг объяснения.
+type Step struct {
+	Russian string // Описание шага на русском языке
+	English string // Описание шага на английском языке
+	Formula string // Формула или выражение (может быть пустым)
+}
+
+// Возвращает описание шага на заданном языке.
+func (s Step) Text(language Language) string {
+	if language == English {
+		return s.English
+	}
+	return s.Russian
+}
+
+// Trace представляет собой пошаговое объяснение алгоритма.
+type Trace struct {
+	title  Step   // Название алгоритма
+	steps  []Step // Шаги алгоритма
+	result string // Ответ
+}
+
+// Возвращает пустое объяснение с заданным названием.
+func NewTrace(russian string, english string) *Trace {
+	return &Trace{Step{russian, english, ""}, []Step{}, ""}
+}
+
+// Добавляет шаг в конец объяснения.
+func (t *Trace) Add(russian string, english string, formula string) {
+	t.steps = append(t.steps, Step{russian, english, formula})
+}
+
+// Записывает ответ.
+func (t *Trace) SetResult(result string) {
+	t.result = result
+}
+
+// Возвращает название алгоритма на заданном языке.
+func (t *Trace) Title(language Language) string {
+	return t.title.Text(language)
+}
+
+// Возвращает шаги объяснения.
+func (t *Trace) Steps() []Step {
+	return t.steps
+}
+
+// Возвращает ответ.
+func (t *Trace) Result() string {
+	return t.result
+}
+
+// Возвращает объяснение простым текстом.
+//
+// Пример:
+//
+//	Количество инверсий
+//	1. Пара (1, 4): 2 > 1
+//	2. Пара (2, 4): 3 > 1
+//	Ответ: 2
+func (t *Trace) Text(language Language) string {
+	var builder strings.Builder
+	builder.WriteString(t.title.Text(language))
+	builder.WriteString("\n")
+	for i, step := range t.steps {
+		fmt.Fprintf(&builder, "%d. %s", i+1, step.Text(language))
+		if step.Formula != "" {
+			fmt.Fprintf(&builder, ": %s", step.Formula)
+		}
+		builder.WriteString("\n")
+	}
+	if t.result != "" {
+		fmt.Fprintf(&builder, "%s: %s\n", resultLabel(language), t.result)
+	}
+	return builder.String()
+}
+
+// Возвращает объяснение в формате Markdown. Формулы выделяются как код.
+func (t *Trace) Markdown(language Language) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "### %s\n\n", t.title.Text(language))
+	for i, step := range t.steps {
+		fmt.Fprintf(&builder, "%d. %s", i+1, step.Text(language))
+		if step.Formula != "" {
+			fmt.Fprintf(&builder, ": `%s`", step.Formula)
+		}
+		builder.WriteString("\n")
+	}
+	if t.result != "" {
+		if len(t.steps) > 0 {
+			builder.WriteString("\n")
+		}
+		fmt.Fprintf(&builder, "**%s:** `%s`\n", resultLabel(language), t.result)
+	}
+	return builder.String()
+}
+
+// Возвращает подпись к ответу на заданном языке.
+func resultLabel(language Language) string {
+	if language == English {
+		return "Result"
+	}
+	return "Ответ"
+}
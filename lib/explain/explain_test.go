@@ -0,0 +1,49 @@
+package explain
+
+import "testing"
+
+// Should render trace as plain text and Markdown
+func TestTraceRender(t *testing.T) {
+	trace := NewTrace("Количество инверсий", "Inversions count")
+	trace.Add("Пара (1, 4)", "Pair (1, 4)", "2 > 1")
+	trace.Add("Пара (2, 4)", "Pair (2, 4)", "3 > 1")
+	trace.Add("Других инверсий нет", "No more inversions", "")
+	trace.SetResult("2")
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"text ru", trace.Text(Russian), "Количество инверсий\n1. Пара (1, 4): 2 > 1\n2. Пара (2, 4): 3 > 1\n3. Других инверсий нет\nОтвет: 2\n"},
+		{"text en", trace.Text(English), "Inversions count\n1. Pair (1, 4): 2 > 1\n2. Pair (2, 4): 3 > 1\n3. No more inversions\nResult: 2\n"},
+		{"markdown ru", trace.Markdown(Russian), "### Количество инверсий\n\n1. Пара (1, 4): `2 > 1`\n2. Пара (2, 4): `3 > 1`\n3. Других инверсий нет\n\n**Ответ:** `2`\n"},
+		{"markdown en", trace.Markdown(English), "### Inversions count\n\n1. Pair (1, 4): `2 > 1`\n2. Pair (2, 4): `3 > 1`\n3. No more inversions\n\n**Result:** `2`\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// Should keep steps in order
+func TestTraceSteps(t *testing.T) {
+	trace := NewTrace("Название", "Title")
+	if len(trace.Steps()) != 0 {
+		t.Fatalf("got %d steps, want 0", len(trace.Steps()))
+	}
+	trace.Add("первый", "first", "1")
+	trace.Add("второй", "second", "2")
+
+	steps := trace.Steps()
+	if len(steps) != 2 || steps[0].English != "first" || steps[1].Russian != "второй" {
+		t.Errorf("got %v", steps)
+	}
+	if trace.Title(English) != "Title" || trace.Title(Russian) != "Название" {
+		t.Errorf("got titles %q, %q", trace.Title(Russian), trace.Title(English))
+	}
+}
@@ -0,0 +1,199 @@
+package matrices
+
+import "math"
+
+// Разложение Холецкого симметричной положительно определённой матрицы:
+// A = LLᵀ.
+type CholeskyDecomposition struct {
+	L Matrix // Нижнетреугольная матрица с положительной диагональю
+}
+
+// Способ проверки положительной определённости матрицы.
+type PositiveDefiniteMethod byte
+
+const (
+	// Разложение Холецкого: матрица положительно определена, если
+	// разложение существует.
+	PositiveDefiniteCholesky PositiveDefiniteMethod = iota
+	// Критерий Сильвестра: все угловые миноры положительны.
+	PositiveDefiniteSylvester
+)
+
+// Возвращает разложение Холецкого симметричной положительно определённой
+// матрицы.
+//
+// Возвращает ошибку, если матрица не квадратная, не симметричная или не
+// положительно определённая. В последнем случае ошибка содержит номер
+// ведущего элемента, который оказался неположительным (или близким к нулю).
+func (m Matrix) Cholesky() (CholeskyDecomposition, error) {
+	// Матрица должна быть квадратной и симметричной
+	if m.rows != m.columns {
+		return CholeskyDecomposition{}, NotSquareMatrixError()
+	}
+	if !m.IsSymmetric() {
+		return CholeskyDecomposition{}, NotSymmetricMatrixError()
+	}
+
+	n := m.rows
+	l := ZeroMatrix(n, n)
+
+	scale := 0.0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			scale = max(scale, math.Abs(m.row(i)[j]))
+		}
+	}
+	threshold := Epsilon * scale
+
+	for j := 0; j < n; j++ {
+		// l[j][j]² = a[j][j] - Σ l[j][k]²
+		pivot := m.row(j)[j]
+		for k := 0; k < j; k++ {
+			pivot -= l.row(j)[k] * l.row(j)[k]
+		}
+		if pivot <= threshold {
+			return CholeskyDecomposition{}, NotPositiveDefiniteError(j+1, pivot)
+		}
+		l.row(j)[j] = math.Sqrt(pivot)
+
+		// l[i][j] = (a[i][j] - Σ l[i][k] l[j][k]) / l[j][j]
+		for i := j + 1; i < n; i++ {
+			sum := m.row(i)[j]
+			for k := 0; k < j; k++ {
+				sum -= l.row(i)[k] * l.row(j)[k]
+			}
+			l.row(i)[j] = sum / l.row(j)[j]
+		}
+	}
+
+	return CholeskyDecomposition{l}, nil
+}
+
+// Возвращает решение системы Ax = b, используя готовое разложение: сначала
+// решается Ly = b, затем Lᵀx = y.
+//
+// Возвращает ошибку, если длина b не равна порядку матрицы.
+func (d CholeskyDecomposition) Solve(b []float64) ([]float64, error) {
+	n := d.L.rows
+	if len(b) != n {
+		return nil, NotSameSizeError(n, 1, len(b), 1)
+	}
+
+	// Прямая подстановка
+	y := make([]float64, n)
+	for i := 0; i < n; i++ {
+		y[i] = b[i]
+		for j := 0; j < i; j++ {
+			y[i] -= d.L.row(i)[j] * y[j]
+		}
+		y[i] /= d.L.row(i)[i]
+	}
+
+	// Обратная подстановка
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		x[i] = y[i]
+		for j := i + 1; j < n; j++ {
+			x[i] -= d.L.row(j)[i] * x[j]
+		}
+		x[i] /= d.L.row(i)[i]
+	}
+	return x, nil
+}
+
+// Возвращает true, если матрица квадратная и совпадает со своей
+// транспонированной.
+func (m Matrix) IsSymmetric() bool {
+	if m.rows != m.columns {
+		return false
+	}
+	for i := 0; i < m.rows; i++ {
+		for j := i + 1; j < m.columns; j++ {
+			if m.row(i)[j] != m.row(j)[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Возвращает true, если матрица симметричная и положительно определённая
+// (квадратичная форма xᵀAx положительна для любого ненулевого x).
+// Проверка выполняется попыткой разложения Холецкого.
+func (m Matrix) IsPositiveDefinite() bool {
+	positive, _ := m.IsPositiveDefiniteBy(PositiveDefiniteCholesky)
+	return positive
+}
+
+// Возвращает true, если матрица симметричная и положительно определённая.
+// Проверка выполняется заданным способом.
+//
+// Возвращает ошибку, если способ неизвестен.
+func (m Matrix) IsPositiveDefiniteBy(method PositiveDefiniteMethod) (bool, error) {
+	switch method {
+	case PositiveDefiniteCholesky:
+		_, err := m.Cholesky()
+		return err == nil, nil
+	case PositiveDefiniteSylvester:
+		if !m.IsSymmetric() {
+			return false, nil
+		}
+		minors, _ := m.LeadingMinors()
+		for _, minor := range minors {
+			if minor <= 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+	return false, UnknownMethodError(byte(method))
+}
+
+// Возвращает угловые (главные ведущие) миноры квадратной матрицы:
+// определители левых верхних подматриц порядка 1, 2, ..., n.
+//
+// По критерию Сильвестра симметричная матрица положительно определена, если
+// все угловые миноры положительны, и отрицательно определена, если их знаки
+// чередуются, начиная с минуса.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m Matrix) LeadingMinors() ([]float64, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return nil, NotSquareMatrixError()
+	}
+
+	minors := make([]float64, m.rows)
+	for k := 1; k <= m.rows; k++ {
+		submatrix := ZeroMatrix(k, k)
+		for i := 0; i < k; i++ {
+			copy(submatrix.row(i), m.row(i)[:k])
+		}
+		minors[k-1], _ = submatrix.Determinator()
+	}
+	return minors, nil
+}
+
+// Возвращает true, если матрица квадратная и ортогональная: QᵀQ = E с
+// точностью Epsilon.
+func (m Matrix) IsOrthogonal() bool {
+	if m.rows != m.columns {
+		return false
+	}
+	for i := 0; i < m.columns; i++ {
+		for j := i; j < m.columns; j++ {
+			// Скалярное произведение столбцов i и j
+			product := 0.0
+			for k := 0; k < m.rows; k++ {
+				product += m.row(k)[i] * m.row(k)[j]
+			}
+			if i == j {
+				product--
+			}
+			if math.Abs(product) > Epsilon {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Разложение Холецкого
+func TestCholesky(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		want     [][]float64
+		wantErr  error
+	}{
+		{[][]float64{{4, 2}, {2, 10}}, [][]float64{{2, 0}, {1, 3}}, nil},
+		{
+			[][]float64{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}},
+			[][]float64{{2, 0, 0}, {6, 1, 0}, {-8, 5, 3}},
+			nil,
+		},
+		{[][]float64{{1, 2}, {2, 1}}, nil, NotPositiveDefiniteError(2, -3)},
+		{[][]float64{{1, 1}, {1, 1}}, nil, NotPositiveDefiniteError(2, 0)},
+		{[][]float64{{-1, 0}, {0, 1}}, nil, NotPositiveDefiniteError(1, -1)},
+		{[][]float64{{1, 2}, {3, 4}}, nil, NotSymmetricMatrixError()},
+		{[][]float64{{1, 2}}, nil, NotSquareMatrixError()},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			cholesky, err := matrix.Cholesky()
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while calculating Cholesky: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err == nil && !approximatelyEqual(cholesky.L.rowSlices(), tt.want, 1e-12) {
+				t.Errorf("got %v, want %v", cholesky.L.rowSlices(), tt.want)
+			}
+		})
+	}
+}
+
+// Решение системы с помощью разложения Холецкого
+func TestCholeskySolve(t *testing.T) {
+	matrix, _ := NewMatrix([][]float64{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}})
+	cholesky, _ := matrix.Cholesky()
+
+	x, err := cholesky.Solve([]float64{0, 6, 39})
+	if err != nil {
+		t.Fatalf("got an error while solving: %v", err)
+	}
+	if want := []float64{1, 1, 1}; !approximatelyEqual([][]float64{x}, [][]float64{want}, 1e-9) {
+		t.Errorf("got %v, want %v", x, want)
+	}
+
+	if _, err := cholesky.Solve([]float64{1}); err == nil || err.Error() != NotSameSizeError(3, 1, 1, 1).Error() {
+		t.Errorf("got %v, want %v", err, NotSameSizeError(3, 1, 1, 1))
+	}
+}
+
+// Симметричность и положительная определённость
+func TestIsPositiveDefinite(t *testing.T) {
+	tests := []struct {
+		elements  [][]float64
+		symmetric bool
+		positive  bool
+		minors    []float64
+	}{
+		{[][]float64{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}}, true, true, []float64{2, 3, 4}},
+		{[][]float64{{1, 2}, {2, 1}}, true, false, []float64{1, -3}},
+		{[][]float64{{1, 0}, {0, 0}}, true, false, []float64{1, 0}},
+		{[][]float64{{-2, 1}, {1, -2}}, true, false, []float64{-2, 3}},
+		// Угловые миноры положительны, но матрица не симметрична
+		{[][]float64{{1, 5}, {0, 1}}, false, false, []float64{1, 1}},
+		{[][]float64{}, true, true, []float64{}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			if symmetric := matrix.IsSymmetric(); symmetric != tt.symmetric {
+				t.Errorf("got symmetric %v, want %v", symmetric, tt.symmetric)
+			}
+			for _, method := range []PositiveDefiniteMethod{PositiveDefiniteCholesky, PositiveDefiniteSylvester} {
+				positive, err := matrix.IsPositiveDefiniteBy(method)
+				if err != nil {
+					t.Fatalf("got an error while checking definiteness: %v", err)
+				}
+				if positive != tt.positive {
+					t.Errorf("got positive definite %v by method %d, want %v", positive, method, tt.positive)
+				}
+			}
+			if positive := matrix.IsPositiveDefinite(); positive != tt.positive {
+				t.Errorf("got positive definite %v, want %v", positive, tt.positive)
+			}
+			minors, _ := matrix.LeadingMinors()
+			if !approximatelyEqual([][]float64{minors}, [][]float64{tt.minors}, 1e-12) {
+				t.Errorf("got minors %v, want %v", minors, tt.minors)
+			}
+		})
+	}
+
+	matrix, _ := NewMatrix([][]float64{{1}})
+	if _, err := matrix.IsPositiveDefiniteBy(2); err == nil || err.Error() != UnknownMethodError(2).Error() {
+		t.Errorf("got %v, want %v", err, UnknownMethodError(2))
+	}
+	rectangular, _ := NewMatrix([][]float64{{1, 2}})
+	if _, err := rectangular.LeadingMinors(); err == nil || err.Error() != NotSquareMatrixError().Error() {
+		t.Errorf("got %v, want %v", err, NotSquareMatrixError())
+	}
+}
+
+// Ортогональность
+func TestIsOrthogonal(t *testing.T) {
+	angle := math.Pi / 6
+	tests := []struct {
+		elements [][]float64
+		want     bool
+	}{
+		{[][]float64{{1, 0}, {0, 1}}, true},
+		{[][]float64{{math.Cos(angle), -math.Sin(angle)}, {math.Sin(angle), math.Cos(angle)}}, true},
+		{[][]float64{{0, 1, 0}, {0, 0, 1}, {1, 0, 0}}, true},
+		{[][]float64{{1, 0}, {0, -1}}, true},
+		{[][]float64{{1, 1}, {0, 1}}, false},
+		{[][]float64{{2, 0}, {0, 2}}, false},
+		{[][]float64{{1, 0, 0}, {0, 1, 0}}, false},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			if got := matrix.IsOrthogonal(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Матрица Q из QR-разложения ортогональна
+	matrix, _ := NewMatrix([][]float64{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}})
+	if !matrix.QR().Q.IsOrthogonal() {
+		t.Errorf("got non-orthogonal Q")
+	}
+}
@@ -0,0 +1,172 @@
+package matrices
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Направление разложения определителя по теореме Лапласа.
+type ExpansionDirection byte
+
+const (
+	// Разложение по строке.
+	ExpandRow ExpansionDirection = iota
+	// Разложение по столбцу.
+	ExpandColumn
+)
+
+// Слагаемое разложения определителя: элемент, умноженный на его
+// алгебраическое дополнение. Строки и столбцы нумеруются с нуля.
+type ExpansionTerm struct {
+	Row      int     // Строка элемента
+	Column   int     // Столбец элемента
+	Element  float64 // Элемент aᵢⱼ
+	Minor    float64 // Минор Mᵢⱼ
+	Cofactor float64 // Алгебраическое дополнение Aᵢⱼ = (-1)^(i+j) * Mᵢⱼ
+	Value    float64 // Слагаемое aᵢⱼ * Aᵢⱼ
+}
+
+// Разложение определителя по строке или столбцу: det A = Σ aᵢⱼ * Aᵢⱼ.
+type DeterminatorExpansion struct {
+	Terms        []ExpansionTerm // Слагаемые разложения
+	Determinator float64         // Определитель, равный сумме слагаемых
+}
+
+// Возвращает запись слагаемого, в которой строки и столбцы нумеруются с
+// единицы.
+//
+// Пример:
+//
+//	a12 * A12 = 2 * (-6) = -12
+func (t ExpansionTerm) String() string {
+	return fmt.Sprintf("a%d%d * A%d%d = %s * %s = %g",
+		t.Row+1, t.Column+1, t.Row+1, t.Column+1, formatNumber(t.Element), formatNumber(t.Cofactor), t.Value)
+}
+
+// Возвращает запись разложения.
+//
+// Пример:
+//
+//	det A = 1 * (-3) + 2 * 6 + 3 * (-3) = 0
+func (e DeterminatorExpansion) String() string {
+	products := make([]string, len(e.Terms))
+	for i, term := range e.Terms {
+		products[i] = fmt.Sprintf("%s * %s", formatNumber(term.Element), formatNumber(term.Cofactor))
+	}
+	return fmt.Sprintf("det A = %s = %g", strings.Join(products, " + "), e.Determinator)
+}
+
+// Возвращает минор Mᵢⱼ квадратной матрицы: определитель матрицы без
+// заданной строки и столбца (нумерация с нуля).
+//
+// Возвращает ошибку, если матрица не квадратная или строка или столбец
+// находятся за её пределами.
+func (m Matrix) Minor(row int, column int) (float64, error) {
+	if err := m.checkSquareIndex(row, column); err != nil {
+		return 0, err
+	}
+	submatrix := m.minor(row, column)
+	return submatrix.Determinator()
+}
+
+// Возвращает алгебраическое дополнение Aᵢⱼ = (-1)^(i+j) * Mᵢⱼ элемента
+// квадратной матрицы (нумерация с нуля).
+//
+// Возвращает ошибку, если матрица не квадратная или строка или столбец
+// находятся за её пределами.
+func (m Matrix) Cofactor(row int, column int) (float64, error) {
+	minor, err := m.Minor(row, column)
+	if err != nil {
+		return 0, err
+	}
+	return signedMinor(row, column, minor), nil
+}
+
+// Возвращает матрицу алгебраических дополнений: на месте каждого элемента
+// стоит его алгебраическое дополнение.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m Matrix) CofactorMatrix() (Matrix, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return Matrix{}, NotSquareMatrixError()
+	}
+
+	result := ZeroMatrix(m.rows, m.columns)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.columns; j++ {
+			result.row(i)[j], _ = m.Cofactor(i, j)
+		}
+	}
+	return result, nil
+}
+
+// Возвращает присоединённую (союзную) матрицу: транспонированную матрицу
+// алгебраических дополнений. Для любой квадратной матрицы
+// A * adj(A) = det A * E.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m Matrix) Adjugate() (Matrix, error) {
+	cofactors, err := m.CofactorMatrix()
+	if err != nil {
+		return Matrix{}, err
+	}
+	return cofactors.Transpose(), nil
+}
+
+// Возвращает разложение определителя по заданной строке или столбцу
+// (нумерация с нуля) со всеми слагаемыми. Миноры вычисляются методом
+// Determinator.
+//
+// Возвращает ошибку, если матрица не квадратная, направление неизвестно или
+// строка или столбец находятся за пределами матрицы.
+func (m Matrix) LaplaceExpansion(direction ExpansionDirection, index int) (DeterminatorExpansion, error) {
+	var row, column int
+	switch direction {
+	case ExpandRow:
+		row = index
+	case ExpandColumn:
+		column = index
+	default:
+		return DeterminatorExpansion{}, UnknownMethodError(byte(direction))
+	}
+	if err := m.checkSquareIndex(row, column); err != nil {
+		return DeterminatorExpansion{}, err
+	}
+
+	expansion := DeterminatorExpansion{make([]ExpansionTerm, m.rows), 0}
+	for k := 0; k < m.rows; k++ {
+		i, j := row, k
+		if direction == ExpandColumn {
+			i, j = k, column
+		}
+		minor, _ := m.Minor(i, j)
+		cofactor := signedMinor(i, j, minor)
+		// Прибавление нуля превращает -0 в 0
+		value := m.row(i)[j]*cofactor + 0
+		expansion.Terms[k] = ExpansionTerm{i, j, m.row(i)[j], minor, cofactor, value}
+		expansion.Determinator += value
+	}
+	return expansion, nil
+}
+
+// Проверяет, что матрица квадратная, а строка и столбец (нумерация с нуля)
+// находятся в её пределах.
+func (m Matrix) checkSquareIndex(row int, column int) error {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return NotSquareMatrixError()
+	}
+	if row < 0 || row >= m.rows || column < 0 || column >= m.columns {
+		return IndexOutOfRangeError(row+1, column+1)
+	}
+	return nil
+}
+
+// Возвращает минор со знаком (-1)^(i+j). Прибавление нуля превращает -0 в 0.
+func signedMinor(row int, column int, minor float64) float64 {
+	if (row+column)%2 == 1 {
+		return -minor + 0
+	}
+	return minor
+}
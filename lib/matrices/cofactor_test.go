@@ -0,0 +1,157 @@
+package matrices
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Миноры и алгебраические дополнения
+func TestMinorCofactor(t *testing.T) {
+	matrix, _ := NewMatrix([][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}})
+	tests := []struct {
+		row      int
+		column   int
+		minor    float64
+		cofactor float64
+		wantErr  error
+	}{
+		{0, 0, 2, 2, nil},
+		{0, 1, -2, 2, nil},
+		{1, 2, -6, 6, nil},
+		{2, 2, -3, -3, nil},
+		{3, 0, 0, 0, IndexOutOfRangeError(4, 1)},
+		{0, -1, 0, 0, IndexOutOfRangeError(1, 0)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("row=%d column=%d", tt.row, tt.column)
+		t.Run(testname, func(t *testing.T) {
+			minor, err := matrix.Minor(tt.row, tt.column)
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while calculating Minor: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if minor != tt.minor {
+				t.Errorf("got minor %g, want %g", minor, tt.minor)
+			}
+			cofactor, _ := matrix.Cofactor(tt.row, tt.column)
+			if cofactor != tt.cofactor {
+				t.Errorf("got cofactor %g, want %g", cofactor, tt.cofactor)
+			}
+		})
+	}
+
+	rectangular, _ := NewMatrix([][]float64{{1, 2}})
+	if _, err := rectangular.Cofactor(0, 0); err == nil || err.Error() != NotSquareMatrixError().Error() {
+		t.Errorf("got %v, want %v", err, NotSquareMatrixError())
+	}
+}
+
+// Матрица алгебраических дополнений и присоединённая матрица
+func TestAdjugate(t *testing.T) {
+	tests := []struct {
+		elements  [][]float64
+		cofactors [][]float64
+		adjugate  [][]float64
+	}{
+		{[][]float64{{5}}, [][]float64{{1}}, [][]float64{{1}}},
+		{[][]float64{{1, 2}, {3, 4}}, [][]float64{{4, -3}, {-2, 1}}, [][]float64{{4, -2}, {-3, 1}}},
+		{
+			[][]float64{{1, 2, 3}, {0, 1, 4}, {5, 6, 0}},
+			[][]float64{{-24, 20, -5}, {18, -15, 4}, {5, -4, 1}},
+			[][]float64{{-24, 18, 5}, {20, -15, -4}, {-5, 4, 1}},
+		},
+		// Присоединённая матрица существует и для вырожденной матрицы
+		{[][]float64{{1, 2}, {2, 4}}, [][]float64{{4, -2}, {-2, 1}}, [][]float64{{4, -2}, {-2, 1}}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			cofactors, err := matrix.CofactorMatrix()
+			if err != nil {
+				t.Fatalf("got an error while calculating CofactorMatrix: %v", err)
+			}
+			if !approximatelyEqual(cofactors.rowSlices(), tt.cofactors, 1e-12) {
+				t.Errorf("got cofactors %v, want %v", cofactors.rowSlices(), tt.cofactors)
+			}
+			adjugate, _ := matrix.Adjugate()
+			if !approximatelyEqual(adjugate.rowSlices(), tt.adjugate, 1e-12) {
+				t.Errorf("got adjugate %v, want %v", adjugate.rowSlices(), tt.adjugate)
+			}
+		})
+	}
+
+	rectangular, _ := NewMatrix([][]float64{{1, 2}})
+	if _, err := rectangular.Adjugate(); err == nil || err.Error() != NotSquareMatrixError().Error() {
+		t.Errorf("got %v, want %v", err, NotSquareMatrixError())
+	}
+}
+
+// Разложение определителя по строке и столбцу
+func TestLaplaceExpansion(t *testing.T) {
+	matrix, _ := NewMatrix([][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+	tests := []struct {
+		direction ExpansionDirection
+		index     int
+		want      string
+		terms     []string
+		wantErr   error
+	}{
+		{
+			ExpandRow, 0, "det A = 1 * (-3) + 2 * 6 + 3 * (-3) = 0",
+			[]string{"a11 * A11 = 1 * (-3) = -3", "a12 * A12 = 2 * 6 = 12", "a13 * A13 = 3 * (-3) = -9"},
+			nil,
+		},
+		{
+			ExpandColumn, 1, "det A = 2 * 6 + 5 * (-12) + 8 * 6 = 0",
+			[]string{"a12 * A12 = 2 * 6 = 12", "a22 * A22 = 5 * (-12) = -60", "a32 * A32 = 8 * 6 = 48"},
+			nil,
+		},
+		{ExpandRow, 3, "", nil, IndexOutOfRangeError(4, 1)},
+		{ExpandColumn, -1, "", nil, IndexOutOfRangeError(1, 0)},
+		{2, 0, "", nil, UnknownMethodError(2)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("direction=%d index=%d", tt.direction, tt.index)
+		t.Run(testname, func(t *testing.T) {
+			expansion, err := matrix.LaplaceExpansion(tt.direction, tt.index)
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while calculating LaplaceExpansion: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if expansion.String() != tt.want {
+				t.Errorf("got %q, want %q", expansion.String(), tt.want)
+			}
+			for i, term := range expansion.Terms {
+				if term.String() != tt.terms[i] {
+					t.Errorf("got term %q, want %q", term.String(), tt.terms[i])
+				}
+			}
+		})
+	}
+
+	// Разложение по любой строке даёт тот же определитель
+	other, _ := NewMatrix([][]float64{{2, 0, 1, 3}, {1, -1, 0, 2}, {0, 4, 1, 1}, {3, 1, 2, 0}})
+	want, _ := other.DeterminatorBy(DeterminatorLaplace)
+	for i := 0; i < 4; i++ {
+		for _, direction := range []ExpansionDirection{ExpandRow, ExpandColumn} {
+			expansion, _ := other.LaplaceExpansion(direction, i)
+			if expansion.Determinator != want {
+				t.Errorf("got %g by direction %d index %d, want %g", expansion.Determinator, direction, i, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package matrices
+
+import (
+	"math"
+
+	"github.com/wadrodrog/math-helper/lib/permutations"
+)
+
+// LU-разложение квадратной матрицы с перестановкой строк: PA = LU.
+type LUDecomposition struct {
+	L Matrix                    // Нижнетреугольная матрица с единицами на диагонали
+	U Matrix                    // Верхнетреугольная матрица
+	P *permutations.Permutation // Перестановка строк: i-я строка PA - это P(i)-я строка A
+}
+
+// LDLᵀ-разложение симметричной матрицы: A = LDLᵀ.
+type LDLDecomposition struct {
+	L Matrix // Нижнетреугольная матрица с единицами на диагонали
+	D Matrix // Диагональная матрица
+}
+
+// Возвращает LU-разложение матрицы с частичным выбором ведущего элемента:
+// в каждом столбце ведущим выбирается наибольший по модулю элемент.
+//
+// Возвращает ошибку, если матрица не квадратная, вырожденная или близка к
+// вырожденной. В последних двух случаях разложение всё равно возвращается,
+// так как равенство PA = LU выполняется и для вырожденных матриц.
+func (m Matrix) LU() (LUDecomposition, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return LUDecomposition{}, NotSquareMatrixError()
+	}
+
+	lu, pivot := m.decomposeLU()
+	if pivot == -1 {
+		return lu, nil
+	}
+	value := lu.U.row(pivot)[pivot]
+	if value == 0 {
+		return lu, SingularMatrixError(pivot + 1)
+	}
+	return lu, NearSingularMatrixError(pivot+1, value)
+}
+
+// Выполняет LU-разложение квадратной матрицы. Возвращает разложение и индекс
+// первого ведущего элемента, который равен нулю или близок к нему (-1, если
+// таких нет).
+func (m Matrix) decomposeLU() (LUDecomposition, int) {
+	n := m.rows
+	u := m.clone()
+	l := IdentityMatrix(n)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+
+	// Порог, ниже которого ведущий элемент считается близким к нулю
+	scale := 0.0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			scale = max(scale, math.Abs(m.row(i)[j]))
+		}
+	}
+	threshold := Epsilon * scale
+
+	badPivot := -1
+	for k := 0; k < n; k++ {
+		// Выбираем ведущий элемент, наибольший по модулю в столбце
+		pivot := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(u.row(i)[k]) > math.Abs(u.row(pivot)[k]) {
+				pivot = i
+			}
+		}
+
+		// Меняем строки местами в U, в уже найденной части L и в перестановке
+		if pivot != k {
+			u.swapRows(pivot, k)
+			for j := 0; j < k; j++ {
+				l.row(pivot)[j], l.row(k)[j] = l.row(k)[j], l.row(pivot)[j]
+			}
+			order[pivot], order[k] = order[k], order[pivot]
+		}
+
+		if math.Abs(u.row(k)[k]) <= threshold {
+			if badPivot == -1 {
+				badPivot = k
+			}
+			// Весь столбец нулевой: исключать нечего
+			if u.row(k)[k] == 0 {
+				continue
+			}
+		}
+
+		// Обнуляем элементы под ведущим, запоминая множители в L
+		for i := k + 1; i < n; i++ {
+			factor := u.row(i)[k] / u.row(k)[k]
+			l.row(i)[k] = factor
+			u.row(i)[k] = 0
+			for j := k + 1; j < n; j++ {
+				u.row(i)[j] -= factor * u.row(k)[j]
+			}
+		}
+	}
+
+	// Перестановка задаётся числами от 1 до n
+	values := make([]int, n)
+	for i := range order {
+		values[i] = order[i] + 1
+	}
+	p, _ := permutations.NewSequencePermutation(n, values)
+
+	return LUDecomposition{l, u, p}, badPivot
+}
+
+// Возвращает матрицу перестановки P, то есть единичную матрицу с
+// переставленными строками.
+func (d LUDecomposition) PermutationMatrix() Matrix {
+	n := d.U.rows
+	result := ZeroMatrix(n, n)
+	for i := 0; i < n; i++ {
+		result.row(i)[d.pivotRow(i)] = 1
+	}
+	return result
+}
+
+// Возвращает определитель исходной матрицы: произведение диагональных
+// элементов U со знаком перестановки P.
+func (d LUDecomposition) Determinator() float64 {
+	determinator := 1.0
+	if !d.P.IsEven() {
+		determinator = -1
+	}
+	for i := 0; i < d.U.rows; i++ {
+		determinator *= d.U.row(i)[i]
+	}
+	return determinator
+}
+
+// Возвращает решение системы Ax = b, используя готовое разложение: сначала
+// решается Ly = Pb (прямая подстановка), затем Ux = y (обратная подстановка).
+//
+// Возвращает ошибку, если длина b не равна порядку матрицы или матрица
+// вырожденная.
+func (d LUDecomposition) Solve(b []float64) ([]float64, error) {
+	n := d.U.rows
+	if len(b) != n {
+		return nil, NotSameSizeError(n, 1, len(b), 1)
+	}
+
+	// Прямая подстановка
+	y := make([]float64, n)
+	for i := 0; i < n; i++ {
+		y[i] = b[d.pivotRow(i)]
+		for j := 0; j < i; j++ {
+			y[i] -= d.L.row(i)[j] * y[j]
+		}
+	}
+
+	// Обратная подстановка
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		if d.U.row(i)[i] == 0 {
+			return nil, SingularMatrixError(i + 1)
+		}
+		x[i] = y[i]
+		for j := i + 1; j < n; j++ {
+			x[i] -= d.U.row(i)[j] * x[j]
+		}
+		x[i] /= d.U.row(i)[i]
+	}
+
+	return x, nil
+}
+
+// Возвращает индекс строки A, которая стоит на i-м месте в PA.
+func (d LUDecomposition) pivotRow(i int) int {
+	return d.P.Value(i+1) - 1
+}
+
+// Возвращает LDLᵀ-разложение симметричной матрицы. Разложение выполняется
+// без перестановки строк.
+//
+// Возвращает ошибку, если матрица не квадратная, не симметричная или на
+// диагонали D получается ноль (или число, близкое к нулю).
+func (m Matrix) LDL() (LDLDecomposition, error) {
+	// Матрица должна быть квадратной и симметричной
+	if m.rows != m.columns {
+		return LDLDecomposition{}, NotSquareMatrixError()
+	}
+	if !m.IsSymmetric() {
+		return LDLDecomposition{}, NotSymmetricMatrixError()
+	}
+
+	n := m.rows
+	l := IdentityMatrix(n)
+	d := ZeroMatrix(n, n)
+
+	scale := 0.0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			scale = max(scale, math.Abs(m.row(i)[j]))
+		}
+	}
+	threshold := Epsilon * scale
+
+	for j := 0; j < n; j++ {
+		// d[j] = a[j][j] - Σ l[j][k]² d[k]
+		pivot := m.row(j)[j]
+		for k := 0; k < j; k++ {
+			pivot -= l.row(j)[k] * l.row(j)[k] * d.row(k)[k]
+		}
+		if pivot == 0 {
+			return LDLDecomposition{}, SingularMatrixError(j + 1)
+		}
+		if math.Abs(pivot) <= threshold {
+			return LDLDecomposition{}, NearSingularMatrixError(j+1, pivot)
+		}
+		d.row(j)[j] = pivot
+
+		// l[i][j] = (a[i][j] - Σ l[i][k] l[j][k] d[k]) / d[j]
+		for i := j + 1; i < n; i++ {
+			sum := m.row(i)[j]
+			for k := 0; k < j; k++ {
+				sum -= l.row(i)[k] * l.row(j)[k] * d.row(k)[k]
+			}
+			l.row(i)[j] = sum / pivot
+		}
+	}
+
+	return LDLDecomposition{l, d}, nil
+}
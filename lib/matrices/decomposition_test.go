@@ -0,0 +1,175 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Сравнивает элементы матриц с заданной точностью
+func approximatelyEqual(got [][]float64, want [][]float64, tolerance float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if len(got[i]) != len(want[i]) {
+			return false
+		}
+		for j := range got[i] {
+			if math.Abs(got[i][j]-want[i][j]) > tolerance {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// LU-разложение: PA = LU
+func TestMatrixLU(t *testing.T) {
+	tests := []struct {
+		elements    [][]float64
+		permutation []int
+		wantErr     error
+	}{
+		{[][]float64{{2, 5, 4}, {1, 3, 2}, {2, 10, 9}}, []int{1, 3, 2}, nil},
+		{[][]float64{{0, 1}, {1, 0}}, []int{2, 1}, nil},
+		{[][]float64{{1, -2, 3}, {4, 0, 6}, {-7, 8, 9}}, []int{3, 2, 1}, nil},
+		{[][]float64{{1, 2}, {2, 4}}, []int{2, 1}, SingularMatrixError(2)},
+		{[][]float64{{0, 0}, {0, 1}}, []int{1, 2}, SingularMatrixError(1)},
+		{[][]float64{{1, 1}, {1, 1 + 1e-12}}, []int{1, 2}, NearSingularMatrixError(2, 1.000088900582341e-12)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing Matrix: %v", err)
+			}
+			lu, err := matrix.LU()
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while decomposing Matrix: %v", err)
+			}
+			if err == nil && tt.wantErr != nil {
+				t.Fatalf("no error %q", tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Errorf("got %q, want %q", err, tt.wantErr)
+			}
+
+			for i := range tt.permutation {
+				if got := lu.P.Value(i + 1); got != tt.permutation[i] {
+					t.Errorf("got P(%d)=%d, want %d", i+1, got, tt.permutation[i])
+				}
+			}
+			for i := 0; i < lu.L.rows; i++ {
+				if lu.L.row(i)[i] != 1 {
+					t.Errorf("L is not unit triangular: %v", lu.L.rowSlices())
+				}
+				for j := i + 1; j < lu.L.columns; j++ {
+					if lu.L.row(i)[j] != 0 || lu.U.row(j)[i] != 0 {
+						t.Errorf("L or U is not triangular: %v, %v", lu.L.rowSlices(), lu.U.rowSlices())
+					}
+				}
+			}
+
+			pa, _ := lu.PermutationMatrix().MultiplyMatrix(matrix)
+			product, _ := lu.L.MultiplyMatrix(lu.U)
+			if !approximatelyEqual(product.rowSlices(), pa.rowSlices(), 1e-9) {
+				t.Errorf("LU=%v, PA=%v", product.rowSlices(), pa.rowSlices())
+			}
+		})
+	}
+
+	matrix, _ := NewMatrix([][]float64{{1, 2, 3}, {4, 5, 6}})
+	if _, err := matrix.LU(); err == nil || err.Error() != NotSquareMatrixError().Error() {
+		t.Errorf("got %v, want %v", err, NotSquareMatrixError())
+	}
+}
+
+// Определитель и решение системы по готовому LU-разложению
+func TestLUDecompositionReuse(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		b        []float64
+		wantDet  float64
+		wantX    []float64
+	}{
+		{[][]float64{{2, 5, 4}, {1, 3, 2}, {2, 10, 9}}, []float64{11, 6, 21}, 5, []float64{1, 1, 1}},
+		{[][]float64{{0, 1}, {1, 0}}, []float64{3, 4}, -1, []float64{4, 3}},
+		{[][]float64{{1, -2, 3}, {4, 0, 6}, {-7, 8, 9}}, []float64{2, 10, 10}, 204, []float64{1, 1, 1}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing Matrix: %v", err)
+			}
+			lu, err := matrix.LU()
+			if err != nil {
+				t.Fatalf("got an error while decomposing Matrix: %v", err)
+			}
+			if got := lu.Determinator(); math.Abs(got-tt.wantDet) > 1e-9 {
+				t.Errorf("got determinator %f, want %f", got, tt.wantDet)
+			}
+			x, err := lu.Solve(tt.b)
+			if err != nil {
+				t.Fatalf("got an error while solving: %v", err)
+			}
+			if !approximatelyEqual([][]float64{x}, [][]float64{tt.wantX}, 1e-9) {
+				t.Errorf("got %v, want %v", x, tt.wantX)
+			}
+		})
+	}
+}
+
+// LDLᵀ-разложение симметричной матрицы
+func TestMatrixLDL(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		wantD    []float64
+		wantErr  error
+	}{
+		{[][]float64{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}}, []float64{4, 1, 9}, nil},
+		{[][]float64{{1, 2}, {2, 1}}, []float64{1, -3}, nil},
+		{[][]float64{{0, 1}, {1, 0}}, nil, SingularMatrixError(1)},
+		{[][]float64{{1, 2}, {3, 4}}, nil, NotSymmetricMatrixError()},
+		{[][]float64{{1, 2, 3}, {4, 5, 6}}, nil, NotSquareMatrixError()},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing Matrix: %v", err)
+			}
+			ldl, err := matrix.LDL()
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while decomposing Matrix: %v", err)
+			}
+			if err == nil && tt.wantErr != nil {
+				t.Fatalf("no error %q", tt.wantErr)
+			}
+			if err != nil {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("got %q, want %q", err, tt.wantErr)
+				}
+				return
+			}
+
+			for i, want := range tt.wantD {
+				if math.Abs(ldl.D.row(i)[i]-want) > 1e-9 {
+					t.Errorf("got D=%v, want %v", ldl.D.rowSlices(), tt.wantD)
+				}
+			}
+			ld, _ := ldl.L.MultiplyMatrix(ldl.D)
+			product, _ := ld.MultiplyMatrix(ldl.L.Transpose())
+			if !approximatelyEqual(product.rowSlices(), tt.elements, 1e-9) {
+				t.Errorf("LDLᵀ=%v, want %v", product.rowSlices(), tt.elements)
+			}
+		})
+	}
+}
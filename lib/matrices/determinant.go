@@ -0,0 +1,89 @@
+package matrices
+
+// Способ вычисления определителя.
+type DeterminatorMethod byte
+
+const (
+	// Явные формулы для матриц 1-3 порядков, LU-разложение для остальных.
+	DeterminatorAuto DeterminatorMethod = iota
+	// LU-разложение с частичным выбором ведущего элемента.
+	DeterminatorLU
+	// Разложение по первой строке (теорема Лапласа). Работает за O(n!),
+	// поэтому подходит только для небольших матриц.
+	DeterminatorLaplace
+)
+
+// Возвращает определитель квадратной матрицы, вычисленный заданным способом.
+//
+// Возвращает ошибку, если матрица не квадратная или способ неизвестен.
+func (m *Matrix) DeterminatorBy(method DeterminatorMethod) (float64, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return 0, NotSquareMatrixError()
+	}
+
+	switch method {
+	case DeterminatorAuto:
+		return m.Determinator()
+	case DeterminatorLU:
+		return m.determinatorLU(), nil
+	case DeterminatorLaplace:
+		return m.determinatorLaplace(), nil
+	}
+	return 0, UnknownMethodError(byte(method))
+}
+
+// Возвращает копию матрицы, не разделяющую с ней элементы.
+func (m Matrix) clone() Matrix {
+	result := ZeroMatrix(m.rows, m.columns)
+	for i := 0; i < m.rows; i++ {
+		copy(result.row(i), m.row(i))
+	}
+	return result
+}
+
+// Возвращает минор матрицы: матрицу без заданной строки и столбца.
+func (m Matrix) minor(row int, column int) Matrix {
+	result := ZeroMatrix(m.rows-1, m.columns-1)
+	for i, k := 0, 0; i < m.rows; i++ {
+		if i == row {
+			continue
+		}
+		for j, l := 0, 0; j < m.columns; j++ {
+			if j == column {
+				continue
+			}
+			result.row(k)[l] = m.row(i)[j]
+			l++
+		}
+		k++
+	}
+	return result
+}
+
+// Вычисляет определитель через LU-разложение.
+func (m Matrix) determinatorLU() float64 {
+	lu, _ := m.decomposeLU()
+	return lu.Determinator()
+}
+
+// Вычисляет определитель разложением по первой строке:
+// det A = Σ (-1)^j * a[0][j] * M[0][j].
+func (m Matrix) determinatorLaplace() float64 {
+	if m.rows == 0 {
+		return 1
+	}
+	if m.rows == 1 {
+		return m.row(0)[0]
+	}
+
+	determinator := 0.0
+	sign := 1.0
+	for j := 0; j < m.columns; j++ {
+		if m.row(0)[j] != 0 {
+			determinator += sign * m.row(0)[j] * m.minor(0, j).determinatorLaplace()
+		}
+		sign = -sign
+	}
+	return determinator
+}
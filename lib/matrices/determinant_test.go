@@ -0,0 +1,74 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Вычисление определителя разными способами
+func TestMatrixDeterminatorBy(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		want     float64
+	}{
+		{[][]float64{}, 1},
+		{[][]float64{{5}}, 5},
+		{[][]float64{{11, -3}, {-15, -2}}, -67},
+		{[][]float64{{1, -2, 3}, {4, 0, 6}, {-7, 8, 9}}, 204},
+		{[][]float64{{2, 5, 4}, {1, 3, 2}, {2, 10, 9}}, 5},
+		{[][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, 0},
+		{[][]float64{{0, 1, 2, 3}, {1, 0, 1, 2}, {2, 1, 0, 1}, {3, 2, 1, 0}}, -12},
+		{[][]float64{{2, 0, 0, 0, 0}, {1, 3, 0, 0, 0}, {4, 5, -1, 0, 0}, {7, 8, 9, 2, 0}, {6, 5, 4, 3, 0.5}}, -6},
+	}
+
+	methods := []DeterminatorMethod{DeterminatorAuto, DeterminatorLU, DeterminatorLaplace}
+
+	for _, tt := range tests {
+		for _, method := range methods {
+			testname := fmt.Sprintf("%d:%v", method, tt.elements)
+			t.Run(testname, func(t *testing.T) {
+				matrix, err := NewMatrix(tt.elements)
+				if err != nil {
+					t.Fatalf("got an error while initializing Matrix: %v", err)
+				}
+				got, err := matrix.DeterminatorBy(method)
+				if err != nil {
+					t.Fatalf("got an error while calculating Matrix Determinator: %v", err)
+				}
+				if math.Abs(got-tt.want) > 1e-9 {
+					t.Errorf("got %f, want %f", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+// Ошибки при вычислении определителя
+func TestMatrixDeterminatorByErrors(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		method   DeterminatorMethod
+		want     error
+	}{
+		{[][]float64{{1, 2, 3}, {4, 5, 6}}, DeterminatorLU, NotSquareMatrixError()},
+		{[][]float64{{1, 2}, {4, 5}}, 42, UnknownMethodError(42)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%d:%v", tt.method, tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing Matrix: %v", err)
+			}
+			_, err = matrix.DeterminatorBy(tt.method)
+			if err == nil {
+				t.Fatalf("no error %q", tt.want)
+			}
+			if err.Error() != tt.want.Error() {
+				t.Errorf("got %q, want %q", err, tt.want)
+			}
+		})
+	}
+}
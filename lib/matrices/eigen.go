@@ -0,0 +1,569 @@
+package matrices
+
+import (
+	"cmp"
+	"math"
+	"math/cmplx"
+	"slices"
+)
+
+// Количество итераций итерационных алгоритмов по умолчанию.
+const DefaultMaxIterations = 50
+
+// Параметры поиска собственных значений.
+type EigenOptions struct {
+	// Максимальное количество итераций QR-алгоритма на одно собственное
+	// значение или количество проходов метода Якоби. Если равно нулю,
+	// используется DefaultMaxIterations.
+	MaxIterations int
+}
+
+// Приведение квадратной матрицы к форме Хессенберга: A = QHQᵀ.
+type HessenbergDecomposition struct {
+	H Matrix // Верхняя матрица Хессенберга (нули ниже первой поддиагонали)
+	Q Matrix // Ортогональная матрица
+}
+
+// Собственные значения и собственные векторы квадратной матрицы.
+type EigenDecomposition struct {
+	Values  []complex128   // Собственные значения
+	Vectors [][]complex128 // Собственные векторы: Vectors[i] соответствует Values[i]
+}
+
+// Собственные значения и собственные векторы симметричной матрицы:
+// A = VΛVᵀ.
+type SymmetricEigenDecomposition struct {
+	Values  []float64 // Собственные значения в порядке возрастания
+	Vectors Matrix    // Ортогональная матрица, i-й столбец которой соответствует Values[i]
+}
+
+// Возвращает максимальное количество итераций с учётом значения по
+// умолчанию.
+func (o EigenOptions) maxIterations() int {
+	if o.MaxIterations <= 0 {
+		return DefaultMaxIterations
+	}
+	return o.MaxIterations
+}
+
+// Приводит матрицу к форме Хессенберга отражениями Хаусхолдера.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m Matrix) Hessenberg() (HessenbergDecomposition, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return HessenbergDecomposition{}, NotSquareMatrixError()
+	}
+
+	n := m.rows
+	h := m.clone()
+	q := IdentityMatrix(n)
+	for k := 0; k+2 < n; k++ {
+		// Отражение обнуляет элементы столбца k ниже поддиагонали
+		norm := 0.0
+		for i := k + 1; i < n; i++ {
+			norm = math.Hypot(norm, h.row(i)[k])
+		}
+		if norm == 0 {
+			continue
+		}
+		alpha := -math.Copysign(norm, h.row(k + 1)[k])
+		v := make([]float64, n)
+		for i := k + 1; i < n; i++ {
+			v[i] = h.row(i)[k]
+		}
+		v[k+1] -= alpha
+		length := 0.0
+		for i := k + 1; i < n; i++ {
+			length = math.Hypot(length, v[i])
+		}
+		for i := k + 1; i < n; i++ {
+			v[i] /= length
+		}
+
+		// H = (E - 2vvᵀ) H (E - 2vvᵀ), Q = Q (E - 2vvᵀ)
+		reflectRows(h.rowSlices(), v, k+1)
+		reflectColumns(h.rowSlices(), v, k+1)
+		reflectColumns(q.rowSlices(), v, k+1)
+		h.row(k + 1)[k] = alpha
+		for i := k + 2; i < n; i++ {
+			h.row(i)[k] = 0
+		}
+	}
+	return HessenbergDecomposition{h, q}, nil
+}
+
+// Возвращает собственные значения матрицы, в том числе комплексные,
+// найденные QR-алгоритмом с двойным сдвигом Фрэнсиса после приведения к
+// форме Хессенберга. Значения упорядочены по возрастанию действительной,
+// затем мнимой части.
+//
+// Возвращает ошибку, если матрица не квадратная или алгоритм не сошёлся.
+func (m Matrix) Eigenvalues() ([]complex128, error) {
+	return m.EigenvaluesWith(EigenOptions{})
+}
+
+// Возвращает собственные значения матрицы с заданными параметрами.
+//
+// Возвращает ошибку, если матрица не квадратная или алгоритм не сошёлся.
+func (m Matrix) EigenvaluesWith(options EigenOptions) ([]complex128, error) {
+	hessenberg, err := m.Hessenberg()
+	if err != nil {
+		return nil, err
+	}
+	values, err := francisQR(hessenberg.H.rowSlices(), options.maxIterations())
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(values, func(a complex128, b complex128) int {
+		return cmp.Or(cmp.Compare(real(a), real(b)), cmp.Compare(imag(a), imag(b)))
+	})
+	return values, nil
+}
+
+// Возвращает собственные значения и собственные векторы матрицы.
+// Собственные векторы находятся методом обратных итераций, нормированы
+// (длина равна единице) и имеют действительную положительную наибольшую по
+// модулю компоненту. Для кратных собственных значений векторы могут
+// совпадать.
+//
+// Возвращает ошибку, если матрица не квадратная или алгоритм не сошёлся.
+func (m Matrix) Eigen() (EigenDecomposition, error) {
+	return m.EigenWith(EigenOptions{})
+}
+
+// Возвращает собственные значения и собственные векторы матрицы с
+// заданными параметрами.
+//
+// Возвращает ошибку, если матрица не квадратная или алгоритм не сошёлся.
+func (m Matrix) EigenWith(options EigenOptions) (EigenDecomposition, error) {
+	values, err := m.EigenvaluesWith(options)
+	if err != nil {
+		return EigenDecomposition{}, err
+	}
+	vectors := make([][]complex128, len(values))
+	for i, value := range values {
+		vectors[i] = m.inverseIteration(value, options.maxIterations())
+	}
+	return EigenDecomposition{values, vectors}, nil
+}
+
+// Возвращает собственные значения и собственные векторы симметричной
+// матрицы, найденные методом вращений Якоби.
+//
+// Возвращает ошибку, если матрица не квадратная, не симметричная или метод
+// не сошёлся.
+func (m Matrix) SymmetricEigen() (SymmetricEigenDecomposition, error) {
+	return m.SymmetricEigenWith(EigenOptions{})
+}
+
+// Возвращает собственные значения и собственные векторы симметричной
+// матрицы с заданными параметрами.
+//
+// Возвращает ошибку, если матрица не квадратная, не симметричная или метод
+// не сошёлся.
+func (m Matrix) SymmetricEigenWith(options EigenOptions) (SymmetricEigenDecomposition, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return SymmetricEigenDecomposition{}, NotSquareMatrixError()
+	}
+	if !m.IsSymmetric() {
+		return SymmetricEigenDecomposition{}, NotSymmetricMatrixError()
+	}
+
+	n := m.rows
+	a := m.clone()
+	v := IdentityMatrix(n)
+	aRows, vRows := a.rowSlices(), v.rowSlices()
+
+	// Метод сходится, когда сумма квадратов внедиагональных элементов
+	// становится пренебрежимо малой относительно всей матрицы
+	total := 0.0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			total += a.row(i)[j] * a.row(i)[j]
+		}
+	}
+
+	converged := false
+	for sweep := 0; sweep < options.maxIterations(); sweep++ {
+		off := 0.0
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				off += a.row(p)[q] * a.row(p)[q]
+			}
+		}
+		if off <= Epsilon*Epsilon*total {
+			converged = true
+			break
+		}
+
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if a.row(p)[q] == 0 {
+					continue
+				}
+				// Вращение обнуляет элементы (p, q) и (q, p)
+				theta := (a.row(q)[q] - a.row(p)[p]) / (2 * a.row(p)[q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+				rotateColumns(aRows, p, q, c, s)
+				rotateRows(aRows, p, q, c, s)
+				rotateColumns(vRows, p, q, c, s)
+				a.row(p)[q], a.row(q)[p] = 0, 0
+			}
+		}
+	}
+	if !converged {
+		return SymmetricEigenDecomposition{}, NoConvergenceError(options.maxIterations())
+	}
+
+	// Упорядочиваем собственные значения по возрастанию
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i int, j int) int {
+		return cmp.Compare(a.row(i)[i], a.row(j)[j])
+	})
+	values := make([]float64, n)
+	vectors := ZeroMatrix(n, n)
+	for k, i := range order {
+		values[k] = a.row(i)[i]
+		for j := 0; j < n; j++ {
+			vectors.row(j)[k] = v.row(j)[i]
+		}
+	}
+	return SymmetricEigenDecomposition{values, vectors}, nil
+}
+
+// Умножает строки start..n-1 слева на отражение E - 2vvᵀ.
+func reflectRows(elements [][]float64, v []float64, start int) {
+	for j := range elements[0] {
+		sum := 0.0
+		for i := start; i < len(elements); i++ {
+			sum += v[i] * elements[i][j]
+		}
+		for i := start; i < len(elements); i++ {
+			elements[i][j] -= 2 * v[i] * sum
+		}
+	}
+}
+
+// Умножает столбцы start..n-1 справа на отражение E - 2vvᵀ.
+func reflectColumns(elements [][]float64, v []float64, start int) {
+	for i := range elements {
+		sum := 0.0
+		for j := start; j < len(elements[i]); j++ {
+			sum += elements[i][j] * v[j]
+		}
+		for j := start; j < len(elements[i]); j++ {
+			elements[i][j] -= 2 * sum * v[j]
+		}
+	}
+}
+
+// Заменяет столбцы p и q на c*p - s*q и s*p + c*q.
+func rotateColumns(elements [][]float64, p int, q int, c float64, s float64) {
+	for k := range elements {
+		kp, kq := elements[k][p], elements[k][q]
+		elements[k][p] = c*kp - s*kq
+		elements[k][q] = s*kp + c*kq
+	}
+}
+
+// Заменяет строки p и q на c*p - s*q и s*p + c*q.
+func rotateRows(elements [][]float64, p int, q int, c float64, s float64) {
+	for k := range elements[p] {
+		pk, qk := elements[p][k], elements[q][k]
+		elements[p][k] = c*pk - s*qk
+		elements[q][k] = s*pk + c*qk
+	}
+}
+
+// Находит собственные значения верхней матрицы Хессенберга QR-алгоритмом с
+// двойным сдвигом Фрэнсиса. Когда поддиагональный элемент становится
+// пренебрежимо малым, матрица распадается на блоки: блок 1x1 даёт
+// действительное собственное значение, блок 2x2 - пару значений.
+//
+// Изменяет переданные элементы. Возвращает ошибку, если на одно собственное
+// значение потребовалось больше maxIterations итераций.
+func francisQR(a [][]float64, maxIterations int) ([]complex128, error) {
+	n := len(a)
+	values := make([]complex128, n)
+
+	norm := 0.0
+	for i := 0; i < n; i++ {
+		for j := max(i-1, 0); j < n; j++ {
+			norm += math.Abs(a[i][j])
+		}
+	}
+
+	const eps = 0x1p-52
+	shift := 0.0
+	last := n - 1
+	for last >= 0 {
+		iterations := 0
+		for {
+			// Ищем пренебрежимо малый поддиагональный элемент
+			l := last
+			for ; l > 0; l-- {
+				s := math.Abs(a[l-1][l-1]) + math.Abs(a[l][l])
+				if s == 0 {
+					s = norm
+				}
+				if math.Abs(a[l][l-1]) <= eps*s {
+					a[l][l-1] = 0
+					break
+				}
+			}
+
+			x := a[last][last]
+			if l == last {
+				// Отделился блок 1x1
+				values[last] = complex(x+shift, 0)
+				last--
+				break
+			}
+			y := a[last-1][last-1]
+			w := a[last][last-1] * a[last-1][last]
+			if l == last-1 {
+				// Отделился блок 2x2: корни квадратного уравнения
+				p := 0.5 * (y - x)
+				q := p*p + w
+				z := math.Sqrt(math.Abs(q))
+				x += shift
+				if q >= 0 {
+					z = p + math.Copysign(z, p)
+					values[last-1] = complex(x+z, 0)
+					values[last] = complex(x+z, 0)
+					if z != 0 {
+						values[last] = complex(x-w/z, 0)
+					}
+				} else {
+					values[last] = complex(x+p, -z)
+					values[last-1] = complex(x+p, z)
+				}
+				last -= 2
+				break
+			}
+
+			if iterations == maxIterations {
+				return nil, NoConvergenceError(maxIterations)
+			}
+			if iterations == 10 || iterations == 20 {
+				// Исключительный сдвиг помогает выйти из зацикливания
+				shift += x
+				for i := 0; i <= last; i++ {
+					a[i][i] -= x
+				}
+				s := math.Abs(a[last][last-1]) + math.Abs(a[last-1][last-2])
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+			iterations++
+
+			// Ищем два соседних малых поддиагональных элемента
+			var p, q, r, z float64
+			m := last - 2
+			for ; m >= l; m-- {
+				z = a[m][m]
+				r = x - z
+				s := y - z
+				p = (r*s-w)/a[m+1][m] + a[m][m+1]
+				q = a[m+1][m+1] - z - r - s
+				r = a[m+2][m+1]
+				s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				p /= s
+				q /= s
+				r /= s
+				if m == l {
+					break
+				}
+				u := math.Abs(a[m][m-1]) * (math.Abs(q) + math.Abs(r))
+				v := math.Abs(p) * (math.Abs(a[m-1][m-1]) + math.Abs(z) + math.Abs(a[m+1][m+1]))
+				if u <= eps*v {
+					break
+				}
+			}
+			for i := m; i < last-1; i++ {
+				a[i+2][i] = 0
+				if i != m {
+					a[i+2][i-1] = 0
+				}
+			}
+
+			// Двойной шаг QR отражениями Хаусхолдера
+			for k := m; k < last; k++ {
+				if k != m {
+					p = a[k][k-1]
+					q = a[k+1][k-1]
+					r = 0
+					if k+1 != last {
+						r = a[k+2][k-1]
+					}
+					x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+					if x != 0 {
+						p /= x
+						q /= x
+						r /= x
+					}
+				}
+				s := math.Copysign(math.Sqrt(p*p+q*q+r*r), p)
+				if s == 0 {
+					continue
+				}
+				if k == m {
+					if l != m {
+						a[k][k-1] = -a[k][k-1]
+					}
+				} else {
+					a[k][k-1] = -s * x
+				}
+				p += s
+				x = p / s
+				y = q / s
+				z = r / s
+				q /= p
+				r /= p
+				for j := k; j <= last; j++ {
+					p = a[k][j] + q*a[k+1][j]
+					if k+1 != last {
+						p += r * a[k+2][j]
+						a[k+2][j] -= p * z
+					}
+					a[k+1][j] -= p * y
+					a[k][j] -= p * x
+				}
+				for i := l; i <= min(last, k+3); i++ {
+					p = x*a[i][k] + y*a[i][k+1]
+					if k+1 != last {
+						p += z * a[i][k+2]
+						a[i][k+2] -= p * r
+					}
+					a[i][k+1] -= p * q
+					a[i][k] -= p
+				}
+			}
+		}
+	}
+	return values, nil
+}
+
+// Находит собственный вектор, соответствующий собственному значению, методом
+// обратных итераций: x ← (A - μE)⁻¹x, где μ - немного сдвинутое значение,
+// чтобы матрица системы не была вырожденной.
+func (m Matrix) inverseIteration(value complex128, maxIterations int) []complex128 {
+	n := m.rows
+	norm := 0.0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			norm = max(norm, math.Abs(m.row(i)[j]))
+		}
+	}
+	shift := value + complex(Epsilon*max(norm, 1), 0)
+
+	// Матрица A - μE
+	shifted := make([][]complex128, n)
+	for i := range shifted {
+		shifted[i] = make([]complex128, n)
+		for j := range shifted[i] {
+			shifted[i][j] = complex(m.row(i)[j], 0)
+		}
+		shifted[i][i] -= shift
+	}
+
+	vector := make([]complex128, n)
+	for i := range vector {
+		vector[i] = 1
+	}
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		next := normalizeVector(solveComplex(shifted, vector))
+		difference := 0.0
+		for i := range next {
+			difference = max(difference, cmplx.Abs(next[i]-vector[i]))
+		}
+		vector = next
+		if difference <= Epsilon {
+			break
+		}
+	}
+	return vector
+}
+
+// Нормирует вектор так, чтобы его длина была равна единице, а наибольшая по
+// модулю компонента была действительной и положительной.
+func normalizeVector(vector []complex128) []complex128 {
+	largest := 0
+	length := 0.0
+	for i := range vector {
+		length = math.Hypot(length, cmplx.Abs(vector[i]))
+		if cmplx.Abs(vector[i]) > cmplx.Abs(vector[largest]) {
+			largest = i
+		}
+	}
+	if length == 0 {
+		return vector
+	}
+	// Поворачиваем вектор, чтобы наибольшая компонента стала действительной
+	phase := vector[largest] / complex(cmplx.Abs(vector[largest]), 0) * complex(length, 0)
+	result := make([]complex128, len(vector))
+	for i := range vector {
+		result[i] = vector[i] / phase
+	}
+	result[largest] = complex(real(result[largest]), 0)
+	return result
+}
+
+// Решает систему с комплексной квадратной матрицей методом Гаусса с выбором
+// наибольшего по модулю ведущего элемента. Нулевые ведущие элементы
+// заменяются малым числом, поэтому для вырожденной матрицы возвращается
+// вектор, близкий к её ядру.
+func solveComplex(a [][]complex128, b []complex128) []complex128 {
+	n := len(a)
+	u := cloneElements(a)
+	x := slices.Clone(b)
+
+	scale := 0.0
+	for i := range u {
+		for j := range u[i] {
+			scale = max(scale, cmplx.Abs(u[i][j]))
+		}
+	}
+	tiny := complex(Epsilon*max(scale, 1)*Epsilon, 0)
+
+	for k := 0; k < n; k++ {
+		pivot := k
+		for i := k + 1; i < n; i++ {
+			if cmplx.Abs(u[i][k]) > cmplx.Abs(u[pivot][k]) {
+				pivot = i
+			}
+		}
+		u[k], u[pivot] = u[pivot], u[k]
+		x[k], x[pivot] = x[pivot], x[k]
+		if u[k][k] == 0 {
+			u[k][k] = tiny
+		}
+		for i := k + 1; i < n; i++ {
+			factor := u[i][k] / u[k][k]
+			for j := k; j < n; j++ {
+				u[i][j] -= factor * u[k][j]
+			}
+			x[i] -= factor * x[k]
+		}
+	}
+
+	// Обратный ход
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= u[i][j] * x[j]
+		}
+		x[i] /= u[i][i]
+	}
+	return x
+}
@@ -0,0 +1,202 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// Приведение к форме Хессенберга
+func TestHessenberg(t *testing.T) {
+	tests := [][][]float64{
+		{{1, 2}, {3, 4}},
+		{{4, 1, 2, 3}, {1, 5, 6, 7}, {2, 6, 8, 9}, {3, 7, 9, 10}},
+		{{1, 2, 3, 4, 5}, {0, 1, 0, 2, 0}, {3, 0, 1, 0, 4}, {1, 1, 1, 1, 1}, {2, 0, 0, 0, 7}},
+	}
+
+	for _, elements := range tests {
+		testname := fmt.Sprintf("%v", elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(elements)
+			hessenberg, err := matrix.Hessenberg()
+			if err != nil {
+				t.Fatalf("got an error while reducing to Hessenberg form: %v", err)
+			}
+			// Ниже поддиагонали стоят нули
+			for i := 0; i < matrix.rows; i++ {
+				for j := 0; j+1 < i; j++ {
+					if hessenberg.H.row(i)[j] != 0 {
+						t.Errorf("got H[%d][%d] = %g, want 0", i, j, hessenberg.H.row(i)[j])
+					}
+				}
+			}
+			// A = QHQᵀ
+			product, _ := hessenberg.Q.MultiplyMatrix(hessenberg.H)
+			product, _ = product.MultiplyMatrix(hessenberg.Q.Transpose())
+			if !approximatelyEqual(product.rowSlices(), elements, 1e-9) {
+				t.Errorf("got QHQᵀ = %v, want %v", product.rowSlices(), elements)
+			}
+		})
+	}
+
+	matrix, _ := NewMatrix([][]float64{{1, 2, 3}})
+	if _, err := matrix.Hessenberg(); err == nil || err.Error() != NotSquareMatrixError().Error() {
+		t.Errorf("got %v, want %v", err, NotSquareMatrixError())
+	}
+}
+
+// Собственные значения, в том числе комплексные
+func TestEigenvalues(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		want     []complex128
+	}{
+		{[][]float64{}, []complex128{}},
+		{[][]float64{{5}}, []complex128{5}},
+		{[][]float64{{2, 0}, {0, 3}}, []complex128{2, 3}},
+		{[][]float64{{4, 1}, {2, 3}}, []complex128{2, 5}},
+		{[][]float64{{0, -1}, {1, 0}}, []complex128{-1i, 1i}},
+		{[][]float64{{2, 0, 0}, {0, 3, 4}, {0, 4, 9}}, []complex128{1, 2, 11}},
+		{[][]float64{{1, 2, 0}, {-2, 1, 0}, {0, 0, 3}}, []complex128{1 - 2i, 1 + 2i, 3}},
+		// Сопровождающая матрица многочлена (λ-1)(λ-2)(λ-3)(λ-4)
+		{[][]float64{{10, -35, 50, -24}, {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}}, []complex128{1, 2, 3, 4}},
+		// Сопровождающая матрица многочлена λ⁴ - 1
+		{[][]float64{{0, 0, 0, 1}, {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}}, []complex128{-1, -1i, 1i, 1}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			values, err := matrix.Eigenvalues()
+			if err != nil {
+				t.Fatalf("got an error while calculating Eigenvalues: %v", err)
+			}
+			if len(values) != len(tt.want) {
+				t.Fatalf("got %v, want %v", values, tt.want)
+			}
+			for i := range values {
+				if cmplx.Abs(values[i]-tt.want[i]) > 1e-9 {
+					t.Errorf("got %v, want %v", values, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+// Собственные векторы, найденные методом обратных итераций
+func TestEigen(t *testing.T) {
+	tests := [][][]float64{
+		{{4, 1}, {2, 3}},
+		{{0, -1}, {1, 0}},
+		{{2, 0, 0}, {0, 3, 4}, {0, 4, 9}},
+		{{1, 2, 0}, {-2, 1, 0}, {0, 0, 3}},
+		{{10, -35, 50, -24}, {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}},
+		{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}},
+	}
+
+	for _, elements := range tests {
+		testname := fmt.Sprintf("%v", elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(elements)
+			eigen, err := matrix.Eigen()
+			if err != nil {
+				t.Fatalf("got an error while calculating Eigen: %v", err)
+			}
+			for k, value := range eigen.Values {
+				vector := eigen.Vectors[k]
+				// Вектор нормирован
+				length := 0.0
+				for _, component := range vector {
+					length = math.Hypot(length, cmplx.Abs(component))
+				}
+				if math.Abs(length-1) > 1e-9 {
+					t.Errorf("got vector %v of length %g, want 1", vector, length)
+				}
+				// Av = λv
+				for i := 0; i < matrix.rows; i++ {
+					sum := complex128(0)
+					for j := 0; j < matrix.columns; j++ {
+						sum += complex(matrix.row(i)[j], 0) * vector[j]
+					}
+					if cmplx.Abs(sum-value*vector[i]) > 1e-8 {
+						t.Errorf("got Av != λv for λ = %v, v = %v", value, vector)
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+// Собственные значения и векторы симметричной матрицы методом Якоби
+func TestSymmetricEigen(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		want     []float64
+	}{
+		{[][]float64{{2, 1}, {1, 2}}, []float64{1, 3}},
+		{[][]float64{{3, 0}, {0, 3}}, []float64{3, 3}},
+		{[][]float64{{2, 0, 0}, {0, 3, 4}, {0, 4, 9}}, []float64{1, 2, 11}},
+		{[][]float64{{6, -2, 2}, {-2, 5, 0}, {2, 0, 7}}, []float64{3, 6, 9}},
+		{[][]float64{{2, -1, 0, 0}, {-1, 2, -1, 0}, {0, -1, 2, -1}, {0, 0, -1, 2}}, []float64{
+			2 - 2*math.Cos(math.Pi/5), 2 - 2*math.Cos(2*math.Pi/5), 2 - 2*math.Cos(3*math.Pi/5), 2 - 2*math.Cos(4*math.Pi/5),
+		}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			eigen, err := matrix.SymmetricEigen()
+			if err != nil {
+				t.Fatalf("got an error while calculating SymmetricEigen: %v", err)
+			}
+			if !approximatelyEqual([][]float64{eigen.Values}, [][]float64{tt.want}, 1e-9) {
+				t.Errorf("got %v, want %v", eigen.Values, tt.want)
+			}
+			// VᵀV = E
+			product, _ := eigen.Vectors.Transpose().MultiplyMatrix(eigen.Vectors)
+			if !approximatelyEqual(product.rowSlices(), IdentityMatrix(matrix.rows).rowSlices(), 1e-9) {
+				t.Errorf("got VᵀV = %v, want identity", product.rowSlices())
+			}
+			// AV = VΛ
+			left, _ := matrix.MultiplyMatrix(eigen.Vectors)
+			right := eigen.Vectors.clone()
+			for i := 0; i < right.rows; i++ {
+				for j := 0; j < right.columns; j++ {
+					right.row(i)[j] *= eigen.Values[j]
+				}
+			}
+			if !approximatelyEqual(left.rowSlices(), right.rowSlices(), 1e-9) {
+				t.Errorf("got AV = %v, want VΛ = %v", left.rowSlices(), right.rowSlices())
+			}
+		})
+	}
+}
+
+// Ошибки при поиске собственных значений
+func TestEigenErrors(t *testing.T) {
+	symmetric, _ := NewMatrix([][]float64{{4, -2, 2}, {-2, 2, -4}, {2, -4, 11}})
+	if _, err := symmetric.SymmetricEigenWith(EigenOptions{MaxIterations: 1}); err == nil || err.Error() != NoConvergenceError(1).Error() {
+		t.Errorf("got %v, want %v", err, NoConvergenceError(1))
+	}
+
+	general, _ := NewMatrix([][]float64{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 12}, {13, 14, 15, 17}})
+	if _, err := general.EigenvaluesWith(EigenOptions{MaxIterations: 1}); err == nil || err.Error() != NoConvergenceError(1).Error() {
+		t.Errorf("got %v, want %v", err, NoConvergenceError(1))
+	}
+	if _, err := general.Eigenvalues(); err != nil {
+		t.Errorf("got an error while calculating Eigenvalues: %v", err)
+	}
+
+	if _, err := general.SymmetricEigen(); err == nil || err.Error() != NotSymmetricMatrixError().Error() {
+		t.Errorf("got %v, want %v", err, NotSymmetricMatrixError())
+	}
+	rectangular, _ := NewMatrix([][]float64{{1, 2}})
+	if _, err := rectangular.Eigen(); err == nil || err.Error() != NotSquareMatrixError().Error() {
+		t.Errorf("got %v, want %v", err, NotSquareMatrixError())
+	}
+}
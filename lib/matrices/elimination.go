@@ -0,0 +1,67 @@
+package matrices
+
+import "fmt"
+
+// Вид элементарного преобразования строк.
+type RowOperationKind byte
+
+const (
+	// Перестановка строк Row и Other.
+	SwapRows RowOperationKind = iota
+	// Умножение строки Row на ненулевое число Factor.
+	ScaleRow
+	// Прибавление к строке Row строки Other, умноженной на число Factor.
+	AddRow
+)
+
+// Элементарное преобразование строк матрицы действительных чисел.
+type RowOperation = FieldRowOperation[float64]
+
+// Записывает число, заключая отрицательные числа в скобки.
+func formatNumber(number float64) string {
+	if number < 0 {
+		return fmt.Sprintf("(%g)", number)
+	}
+	return fmt.Sprintf("%g", number)
+}
+
+// Применяет к матрице элементарное преобразование строк.
+func (m *Matrix) ApplyRowOperation(operation RowOperation) {
+	applyRowOperationElements(RealField{}, m.rowSlices(), operation)
+}
+
+// Возвращает ступенчатый вид матрицы, полученный методом Гаусса, и
+// последовательность выполненных элементарных преобразований строк.
+func (m Matrix) RowEchelon() (Matrix, []RowOperation) {
+	result, _, operations := m.rowReduce(false)
+	return result, operations
+}
+
+// Возвращает упрощённый (канонический) ступенчатый вид матрицы, полученный
+// методом Гаусса-Жордана, и последовательность выполненных элементарных
+// преобразований строк.
+//
+// В упрощённом ступенчатом виде ведущие элементы равны единице, а остальные
+// элементы их столбцов - нулю.
+func (m Matrix) RREF() (Matrix, []RowOperation) {
+	result, _, operations := m.rowReduce(true)
+	return result, operations
+}
+
+// Приводит матрицу к ступенчатому виду методом Гаусса с выбором наибольшего
+// по модулю ведущего элемента. Если reduced равен true, матрица приводится к
+// упрощённому ступенчатому виду.
+//
+// Возвращает новую матрицу, номера столбцов с ведущими элементами и
+// выполненные преобразования.
+func (m Matrix) rowReduce(reduced bool) (Matrix, []int, []RowOperation) {
+	return m.rowReduceTolerance(reduced, Epsilon)
+}
+
+// Приводит матрицу к ступенчатому виду так же, как rowReduce, но считает
+// нулями элементы, меньшие tolerance относительно наибольшего элемента.
+func (m Matrix) rowReduceTolerance(reduced bool, tolerance float64) (Matrix, []int, []RowOperation) {
+	a := m.clone()
+	pivots, operations := rowReduceElementsTolerance(RealField{}, a.rowSlices(), a.columns, reduced, tolerance)
+	return a, pivots, operations
+}
@@ -0,0 +1,93 @@
+package matrices
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Упрощённый ступенчатый вид и журнал преобразований
+func TestMatrixRREF(t *testing.T) {
+	tests := []struct {
+		elements       [][]float64
+		want           [][]float64
+		wantOperations []string
+	}{
+		{
+			[][]float64{{2, 4}, {1, 3}},
+			[][]float64{{1, 0}, {0, 1}},
+			[]string{"R1 * 0.5", "R2 + (-1) * R1", "R1 + (-2) * R2"},
+		},
+		{
+			[][]float64{{1, 2, 3}, {2, 4, 6}},
+			[][]float64{{1, 2, 3}, {0, 0, 0}},
+			[]string{"R1 <-> R2", "R1 * 0.5", "R2 + (-1) * R1"},
+		},
+		{
+			[][]float64{{0, 2, 4}, {1, 1, 1}},
+			[][]float64{{1, 0, -1}, {0, 1, 2}},
+			[]string{"R1 <-> R2", "R2 * 0.5", "R1 + (-1) * R2"},
+		},
+		{
+			[][]float64{{0, 0}, {0, 0}},
+			[][]float64{{0, 0}, {0, 0}},
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing Matrix: %v", err)
+			}
+			got, operations := matrix.RREF()
+			if !approximatelyEqual(got.rowSlices(), tt.want, 1e-9) {
+				t.Errorf("got %v, want %v", got.rowSlices(), tt.want)
+			}
+			if fmt.Sprintf("%v", operations) != fmt.Sprintf("%v", tt.wantOperations) {
+				t.Errorf("got operations %v, want %v", operations, tt.wantOperations)
+			}
+
+			// Повторное применение преобразований должно дать тот же результат
+			replayed := matrix.clone()
+			for _, operation := range operations {
+				replayed.ApplyRowOperation(operation)
+			}
+			if !approximatelyEqual(replayed.rowSlices(), tt.want, 1e-9) {
+				t.Errorf("replayed %v, want %v", replayed.rowSlices(), tt.want)
+			}
+		})
+	}
+}
+
+// Ступенчатый вид матрицы
+func TestMatrixRowEchelon(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		want     [][]float64
+	}{
+		{[][]float64{{1, 2}, {3, 4}}, [][]float64{{3, 4}, {0, 2.0 / 3}}},
+		{[][]float64{{2, 5, 4}, {1, 3, 2}, {2, 10, 9}}, [][]float64{{2, 5, 4}, {0, 5, 5}, {0, 0, -0.5}}},
+		{[][]float64{{0, 1, 1}, {0, 2, 2}, {1, 0, 0}}, [][]float64{{1, 0, 0}, {0, 2, 2}, {0, 0, 0}}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing Matrix: %v", err)
+			}
+			got, operations := matrix.RowEchelon()
+			if !approximatelyEqual(got.rowSlices(), tt.want, 1e-9) {
+				t.Errorf("got %v, want %v", got.rowSlices(), tt.want)
+			}
+			for _, operation := range operations {
+				if operation.Kind == ScaleRow {
+					t.Errorf("unexpected operation %v", operation)
+				}
+			}
+		})
+	}
+}
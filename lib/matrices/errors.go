@@ -31,3 +31,99 @@ func NotSameSizeError(rows1 int, columns1 int, rows2 int, columns2 int) error {
 func UnableToMultiplyError(columns1 int, rows2 int) error {
 	return &matrixError{4, fmt.Sprintf("First matrix columns (%d) and second matrix rows (%d) are not equal", columns1, rows2)}
 }
+
+// Неизвестный способ вычисления.
+func UnknownMethodError(method byte) error {
+	return &matrixError{5, fmt.Sprintf("Unknown method: %d", method)}
+}
+
+// Матрица вырожденная: ведущий элемент равен нулю.
+func SingularMatrixError(pivot int) error {
+	return &matrixError{6, fmt.Sprintf("Matrix is singular: zero pivot at position %d", pivot)}
+}
+
+// Матрица близка к вырожденной: ведущий элемент слишком мал.
+func NearSingularMatrixError(pivot int, value float64) error {
+	return &matrixError{7, fmt.Sprintf("Matrix is nearly singular: pivot %g at position %d", value, pivot)}
+}
+
+// Матрица должна быть симметричной.
+func NotSymmetricMatrixError() error {
+	return &matrixError{8, "Matrix must be symmetric"}
+}
+
+// Определитель матрицы равен нулю.
+func ZeroDeterminatorError() error {
+	return &matrixError{9, "Matrix determinator is zero"}
+}
+
+// Элемент матрицы не является конечным числом.
+func NotFiniteElementError(row int, column int) error {
+	return &matrixError{10, fmt.Sprintf("Element at row=%d, column=%d is not a finite number", row, column)}
+}
+
+// Деление на ноль.
+func DivisionByZeroError() error {
+	return &matrixError{11, "Division by zero"}
+}
+
+// Неправильный модуль: модуль должен быть не меньше двух.
+func InvalidModulusError(modulus int64) error {
+	return &matrixError{12, fmt.Sprintf("Invalid modulus %d: modulus must be at least 2", modulus)}
+}
+
+// Матрицы должны иметь одинаковый модуль.
+func DifferentModulusError(modulus1 int64, modulus2 int64) error {
+	return &matrixError{13, fmt.Sprintf("Matrix moduli are not the same %d != %d", modulus1, modulus2)}
+}
+
+// Модуль должен быть простым числом.
+func NotPrimeModulusError(modulus int64) error {
+	return &matrixError{14, fmt.Sprintf("Modulus %d is not prime", modulus)}
+}
+
+// Матрица необратима по модулю: определитель не взаимно прост с модулем.
+func NotInvertibleModularMatrixError(determinator int64, modulus int64) error {
+	return &matrixError{15, fmt.Sprintf("Matrix is not invertible modulo %d: determinator %d is not coprime with modulus", modulus, determinator)}
+}
+
+// Итерационный алгоритм не сошёлся за заданное количество итераций.
+func NoConvergenceError(iterations int) error {
+	return &matrixError{16, fmt.Sprintf("Algorithm did not converge in %d iterations", iterations)}
+}
+
+// Векторы линейно зависимы.
+func LinearlyDependentError(vector int) error {
+	return &matrixError{17, fmt.Sprintf("Vector %d is linearly dependent on previous vectors", vector)}
+}
+
+// Степень многочлена должна быть неотрицательной.
+func InvalidDegreeError(degree int) error {
+	return &matrixError{18, fmt.Sprintf("Invalid polynomial degree %d: degree must not be negative", degree)}
+}
+
+// Матрица не является положительно определённой.
+func NotPositiveDefiniteError(pivot int, value float64) error {
+	return &matrixError{19, fmt.Sprintf("Matrix is not positive definite: pivot %g at position %d", value, pivot)}
+}
+
+// Матрица плохо обусловлена: результат может быть неточным.
+func IllConditionedError(condition float64) error {
+	return &matrixError{20, fmt.Sprintf("Matrix is ill-conditioned: condition number %g, result may be inaccurate", condition)}
+}
+
+// Строка или столбец находится за пределами матрицы.
+func IndexOutOfRangeError(row int, column int) error {
+	return &matrixError{21, fmt.Sprintf("Index out of range: row=%d, column=%d", row, column)}
+}
+
+// На элемент нельзя разделить: он ненулевой, но не имеет обратного (например,
+// не взаимно прост с составным модулем).
+func NotInvertibleElementError(element string) error {
+	return &matrixError{22, fmt.Sprintf("Element %s is not invertible", element)}
+}
+
+// Размеры матрицы должны быть неотрицательными.
+func InvalidSizeError(rows int, columns int) error {
+	return &matrixError{23, fmt.Sprintf("Invalid matrix size %dx%d: sizes must not be negative", rows, columns)}
+}
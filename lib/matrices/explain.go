@@ -0,0 +1,152 @@
+package matrices
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wadrodrog/math-helper/lib/explain"
+)
+
+// Возвращает произведение матриц и объяснение: как вычисляется каждый
+// элемент результата (сумма произведений элементов строки первой матрицы на
+// элементы столбца второй).
+//
+// Возвращает ошибку, если матрицы нельзя перемножить.
+func (m Matrix) MultiplyMatrixExplained(other Matrix) (Matrix, *explain.Trace, error) {
+	result, err := m.MultiplyMatrix(other)
+	if err != nil {
+		return Matrix{}, nil, err
+	}
+
+	trace := explain.NewTrace("Умножение матриц", "Matrix multiplication")
+	trace.Add(
+		fmt.Sprintf("Размер результата: %dx%d", result.rows, result.columns),
+		fmt.Sprintf("Result size: %dx%d", result.rows, result.columns),
+		fmt.Sprintf("(%dx%d)(%dx%d)", m.rows, m.columns, other.rows, other.columns),
+	)
+	for i := 0; i < result.rows; i++ {
+		for j := 0; j < result.columns; j++ {
+			terms := make([]string, m.columns)
+			for k := 0; k < m.columns; k++ {
+				terms[k] = fmt.Sprintf("%s*%s", formatNumber(m.row(i)[k]), formatNumber(other.row(k)[j]))
+			}
+			trace.Add(
+				fmt.Sprintf("Элемент c%d%d: строка %d на столбец %d", i+1, j+1, i+1, j+1),
+				fmt.Sprintf("Element c%d%d: row %d times column %d", i+1, j+1, i+1, j+1),
+				fmt.Sprintf("%s = %g", strings.Join(terms, " + "), result.row(i)[j]),
+			)
+		}
+	}
+
+	trace.SetResult(fmt.Sprint(result.rowSlices()))
+	return result, trace, nil
+}
+
+// Возвращает определитель матрицы и объяснение. Для матрицы 2-го порядка
+// записывается формула, для 3-го - все шесть слагаемых правила Саррюса, для
+// остальных - элементарные преобразования LU-разложения с выбором ведущего
+// элемента, по которому вычисляется определитель.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m *Matrix) DeterminatorExplained() (float64, *explain.Trace, error) {
+	determinator, err := m.Determinator()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	trace := explain.NewTrace("Определитель матрицы", "Matrix determinator")
+	a := m.rowSlices()
+	switch m.rows {
+	case 0:
+		trace.Add("Определитель пустой матрицы равен единице", "Determinator of an empty matrix equals one", "")
+	case 1:
+		trace.Add("Определитель матрицы 1-го порядка равен её элементу", "Determinator of a 1x1 matrix equals its element", "")
+	case 2:
+		trace.Add(
+			"Произведение главной диагонали минус произведение побочной",
+			"Main diagonal product minus anti-diagonal product",
+			fmt.Sprintf("%s*%s - %s*%s = %g", formatNumber(a[0][0]), formatNumber(a[1][1]), formatNumber(a[0][1]), formatNumber(a[1][0]), determinator),
+		)
+	case 3:
+		// Правило Саррюса: три слагаемых со знаком плюс и три со знаком минус
+		terms := [][3][2]int{
+			{{0, 0}, {1, 1}, {2, 2}},
+			{{0, 1}, {1, 2}, {2, 0}},
+			{{0, 2}, {1, 0}, {2, 1}},
+			{{0, 2}, {1, 1}, {2, 0}},
+			{{0, 0}, {1, 2}, {2, 1}},
+			{{0, 1}, {1, 0}, {2, 2}},
+		}
+		for t, term := range terms {
+			russian, english := "Слагаемое со знаком плюс", "Term with plus sign"
+			if t >= 3 {
+				russian, english = "Слагаемое со знаком минус", "Term with minus sign"
+			}
+			product := 1.0
+			factors := make([]string, 3)
+			for f, position := range term {
+				element := a[position[0]][position[1]]
+				product *= element
+				factors[f] = formatNumber(element)
+			}
+			if product == 0 {
+				// Избавляемся от отрицательного нуля
+				product = 0
+			}
+			trace.Add(russian, english, fmt.Sprintf("%s = %g", strings.Join(factors, "*"), product))
+		}
+	default:
+		m.explainDeterminatorLU(trace, determinator)
+	}
+
+	trace.SetResult(fmt.Sprintf("%g", determinator))
+	return determinator, trace, nil
+}
+
+// Добавляет в объяснение шаги LU-разложения, по которому вычисляется
+// определитель: перестановки строк и прибавления строк, а затем произведение
+// диагональных элементов U с учётом знака перестановки.
+func (m Matrix) explainDeterminatorLU(trace *explain.Trace, determinator float64) {
+	lu, _ := m.decomposeLU()
+	n := m.rows
+
+	// Строки L и U записаны в окончательном порядке, а преобразования
+	// записываются с номерами строк на момент их выполнения
+	order := make([]int, n)
+	position := make([]int, n)
+	for i := range order {
+		order[i] = i
+		position[lu.P.Value(i+1)-1] = i
+	}
+	swaps := 0
+	for k := 0; k < n; k++ {
+		pivot := k
+		for order[pivot] != lu.P.Value(k+1)-1 {
+			pivot++
+		}
+		if pivot != k {
+			order[pivot], order[k] = order[k], order[pivot]
+			swaps++
+			trace.Add("Перестановка строк меняет знак определителя", "Swapping rows changes the sign", RowOperation{SwapRows, k, pivot, 0}.String())
+		}
+		for i := k + 1; i < n; i++ {
+			if factor := lu.L.row(position[order[i]])[k]; factor != 0 {
+				trace.Add("Прибавление строки не меняет определитель", "Adding a row does not change the determinator", RowOperation{AddRow, i, k, -factor}.String())
+			}
+		}
+	}
+
+	diagonal := make([]string, n)
+	for i := 0; i < n; i++ {
+		diagonal[i] = formatNumber(lu.U.row(i)[i])
+	}
+	product := strings.Join(diagonal, "*")
+	if swaps%2 != 0 {
+		product = fmt.Sprintf("-(%s)", product)
+	}
+	trace.Add(
+		"Определитель треугольной матрицы равен произведению диагональных элементов, знак меняется при нечётном числе перестановок",
+		"Determinator of a triangular matrix is the product of its diagonal, negated after an odd number of swaps",
+		fmt.Sprintf("%s = %g", product, determinator),
+	)
+}
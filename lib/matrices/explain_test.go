@@ -0,0 +1,103 @@
+package matrices
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/wadrodrog/math-helper/lib/explain"
+)
+
+// Объяснение умножения матриц
+func TestMatrixMultiplyExplained(t *testing.T) {
+	matrix1, _ := NewMatrix([][]float64{{-2, 1}, {5, 4}})
+	matrix2, _ := NewMatrix([][]float64{{3}, {-1}})
+
+	got, trace, err := matrix1.MultiplyMatrixExplained(matrix2)
+	if err != nil {
+		t.Fatalf("got an error while multiplying Matrix: %v", err)
+	}
+	if fmt.Sprintf("%v", got.rowSlices()) != "[[-7] [11]]" {
+		t.Errorf("got %v, want [[-7] [11]]", got.rowSlices())
+	}
+
+	want := "Matrix multiplication\n" +
+		"1. Result size: 2x1: (2x2)(2x1)\n" +
+		"2. Element c11: row 1 times column 1: (-2)*3 + 1*(-1) = -7\n" +
+		"3. Element c21: row 2 times column 1: 5*3 + 4*(-1) = 11\n" +
+		"Result: [[-7] [11]]\n"
+	if text := trace.Text(explain.English); text != want {
+		t.Errorf("got %q, want %q", text, want)
+	}
+
+	if _, _, err := matrix2.MultiplyMatrixExplained(matrix2); err == nil {
+		t.Errorf("no error %q", UnableToMultiplyError(1, 2))
+	}
+}
+
+// Объяснение вычисления определителя
+func TestMatrixDeterminatorExplained(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		want     string
+	}{
+		{
+			[][]float64{{11, -3}, {-15, -2}},
+			"Определитель матрицы\n" +
+				"1. Произведение главной диагонали минус произведение побочной: 11*(-2) - (-3)*(-15) = -67\n" +
+				"Ответ: -67\n",
+		},
+		{
+			[][]float64{{1, -2, 3}, {4, 0, 6}, {-7, 8, 9}},
+			"Определитель матрицы\n" +
+				"1. Слагаемое со знаком плюс: 1*0*9 = 0\n" +
+				"2. Слагаемое со знаком плюс: (-2)*6*(-7) = 84\n" +
+				"3. Слагаемое со знаком плюс: 3*4*8 = 96\n" +
+				"4. Слагаемое со знаком минус: 3*0*(-7) = 0\n" +
+				"5. Слагаемое со знаком минус: 1*6*8 = 48\n" +
+				"6. Слагаемое со знаком минус: (-2)*4*9 = -72\n" +
+				"Ответ: 204\n",
+		},
+		{
+			[][]float64{{1, 0, 0, 0}, {0, 0, 2, 0}, {0, 3, 0, 0}, {0, 0, 0, 4}},
+			"Определитель матрицы\n" +
+				"1. Перестановка строк меняет знак определителя: R2 <-> R3\n" +
+				"2. Определитель треугольной матрицы равен произведению диагональных элементов, знак меняется при нечётном числе перестановок: -(1*3*2*4) = -24\n" +
+				"Ответ: -24\n",
+		},
+		{
+			[][]float64{{1, 2, 0, 0}, {3, 4, 0, 0}, {0, 0, 2, 1}, {0, 0, 1, 2}},
+			"Определитель матрицы\n" +
+				"1. Перестановка строк меняет знак определителя: R1 <-> R2\n" +
+				"2. Прибавление строки не меняет определитель: R2 + (-0.3333333333333333) * R1\n" +
+				"3. Прибавление строки не меняет определитель: R4 + (-0.5) * R3\n" +
+				"4. Определитель треугольной матрицы равен произведению диагональных элементов, знак меняется при нечётном числе перестановок: -(3*0.6666666666666667*2*1.5) = -6\n" +
+				"Ответ: -6\n",
+		},
+		{
+			[][]float64{{0, 1, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1}, {1, 0, 0, 0}},
+			"Определитель матрицы\n" +
+				"1. Перестановка строк меняет знак определителя: R1 <-> R4\n" +
+				"2. Перестановка строк меняет знак определителя: R2 <-> R4\n" +
+				"3. Перестановка строк меняет знак определителя: R3 <-> R4\n" +
+				"4. Определитель треугольной матрицы равен произведению диагональных элементов, знак меняется при нечётном числе перестановок: -(1*1*1*1) = -1\n" +
+				"Ответ: -1\n",
+		},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing Matrix: %v", err)
+			}
+			_, trace, err := matrix.DeterminatorExplained()
+			if err != nil {
+				t.Fatalf("got an error while calculating Matrix Determinator: %v", err)
+			}
+			if text := trace.Text(explain.Russian); text != tt.want {
+				t.Errorf("got %q, want %q", text, tt.want)
+			}
+		})
+	}
+}
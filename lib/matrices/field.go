@@ -0,0 +1,147 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"math/cmplx"
+)
+
+// Кольцо: множество элементов с операциями сложения и умножения. Операции не
+// изменяют аргументы и возвращают новые значения.
+type Ring[T any] interface {
+	Zero() T           // Нейтральный элемент по сложению
+	One() T            // Нейтральный элемент по умножению
+	Add(a T, b T) T    // Сумма a + b
+	Sub(a T, b T) T    // Разность a - b
+	Mul(a T, b T) T    // Произведение a * b
+	Neg(a T) T         // Противоположный элемент -a
+	IsZero(a T) bool   // Равен ли элемент нулю
+	Format(a T) string // Запись элемента
+}
+
+// Поле: кольцо, в котором можно разделить на любой ненулевой элемент.
+// Кольцо вычетов по составному модулю полем не является, поэтому перед
+// делением следует проверять обратимость делителя.
+type Field[T any] interface {
+	Ring[T]
+	Div(a T, b T) T        // Частное a / b, где b обратим
+	IsInvertible(a T) bool // Есть ли у элемента обратный по умножению
+}
+
+// Поле с приближённой арифметикой (числа с плавающей точкой). В таких полях
+// алгоритмы выбирают наибольший по модулю ведущий элемент и считают нулями
+// элементы, близкие к нулю. В полях с точной арифметикой ведущим выбирается
+// первый ненулевой элемент.
+type ApproximateField[T any] interface {
+	Field[T]
+	Abs(a T) float64 // Модуль элемента
+}
+
+// Поле действительных чисел.
+type RealField struct{}
+
+func (RealField) Zero() float64                    { return 0 }
+func (RealField) One() float64                     { return 1 }
+func (RealField) Add(a float64, b float64) float64 { return a + b }
+func (RealField) Sub(a float64, b float64) float64 { return a - b }
+func (RealField) Mul(a float64, b float64) float64 { return a * b }
+func (RealField) Div(a float64, b float64) float64 { return a / b }
+func (RealField) Neg(a float64) float64            { return -a }
+func (RealField) IsZero(a float64) bool            { return a == 0 }
+func (RealField) IsInvertible(a float64) bool      { return a != 0 }
+func (RealField) Abs(a float64) float64            { return math.Abs(a) }
+func (RealField) Format(a float64) string          { return fmt.Sprintf("%g", a) }
+
+// Поле комплексных чисел.
+type ComplexField struct{}
+
+func (ComplexField) Zero() complex128                          { return 0 }
+func (ComplexField) One() complex128                           { return 1 }
+func (ComplexField) Add(a complex128, b complex128) complex128 { return a + b }
+func (ComplexField) Sub(a complex128, b complex128) complex128 { return a - b }
+func (ComplexField) Mul(a complex128, b complex128) complex128 { return a * b }
+func (ComplexField) Div(a complex128, b complex128) complex128 { return a / b }
+func (ComplexField) Neg(a complex128) complex128               { return -a }
+func (ComplexField) IsZero(a complex128) bool                  { return a == 0 }
+func (ComplexField) IsInvertible(a complex128) bool            { return a != 0 }
+func (ComplexField) Abs(a complex128) float64                  { return cmplx.Abs(a) }
+func (ComplexField) Format(a complex128) string                { return fmt.Sprintf("%g", a) }
+
+// Поле рациональных чисел с точной арифметикой.
+type RationalField struct{}
+
+func (RationalField) Zero() *big.Rat                      { return new(big.Rat) }
+func (RationalField) One() *big.Rat                       { return big.NewRat(1, 1) }
+func (RationalField) Add(a *big.Rat, b *big.Rat) *big.Rat { return new(big.Rat).Add(a, b) }
+func (RationalField) Sub(a *big.Rat, b *big.Rat) *big.Rat { return new(big.Rat).Sub(a, b) }
+func (RationalField) Mul(a *big.Rat, b *big.Rat) *big.Rat { return new(big.Rat).Mul(a, b) }
+func (RationalField) Div(a *big.Rat, b *big.Rat) *big.Rat { return new(big.Rat).Quo(a, b) }
+func (RationalField) Neg(a *big.Rat) *big.Rat             { return new(big.Rat).Neg(a) }
+func (RationalField) IsZero(a *big.Rat) bool              { return a.Sign() == 0 }
+func (RationalField) IsInvertible(a *big.Rat) bool        { return a.Sign() != 0 }
+func (RationalField) Format(a *big.Rat) string            { return a.RatString() }
+
+// Кольцо вычетов по модулю Modulus. Элементы хранятся как числа от 0 до
+// Modulus-1. Если модуль простой, кольцо является полем GF(p).
+type ModularField struct {
+	Modulus int64 // Модуль
+}
+
+// Приводит число к вычету от 0 до Modulus-1.
+func (f ModularField) Reduce(a int64) int64 {
+	a %= f.Modulus
+	if a < 0 {
+		a += f.Modulus
+	}
+	return a
+}
+
+func (f ModularField) Zero() int64 { return 0 }
+func (f ModularField) One() int64  { return f.Reduce(1) }
+func (f ModularField) Add(a int64, b int64) int64 {
+	return f.Reduce(f.Reduce(a) - f.Modulus + f.Reduce(b))
+}
+func (f ModularField) Sub(a int64, b int64) int64 {
+	return f.Reduce(f.Reduce(a) - f.Reduce(b))
+}
+func (f ModularField) Mul(a int64, b int64) int64 {
+	// Произведение может не поместиться в int64, поэтому считаем в 128 битах
+	hi, lo := bits.Mul64(uint64(f.Reduce(a)), uint64(f.Reduce(b)))
+	return int64(bits.Rem64(hi, lo, uint64(f.Modulus)))
+}
+
+// Делит a на b. Вызывает панику, если b не взаимно прост с модулем: такой
+// делитель нужно отсеять заранее с помощью IsInvertible.
+func (f ModularField) Div(a int64, b int64) int64 {
+	inverse, ok := f.Inverse(b)
+	if !ok {
+		panic(NotInvertibleElementError(f.Format(b)))
+	}
+	return f.Mul(a, inverse)
+}
+func (f ModularField) Neg(a int64) int64     { return f.Reduce(-a) }
+func (f ModularField) IsZero(a int64) bool   { return f.Reduce(a) == 0 }
+func (f ModularField) Format(a int64) string { return fmt.Sprintf("%d", f.Reduce(a)) }
+func (f ModularField) IsInvertible(a int64) bool {
+	_, ok := f.Inverse(a)
+	return ok
+}
+
+// Возвращает обратный по умножению элемент, найденный расширенным
+// алгоритмом Евклида, и true. Если обратного элемента нет (a и модуль не
+// взаимно просты), возвращает 0 и false.
+func (f ModularField) Inverse(a int64) (int64, bool) {
+	oldR, r := f.Reduce(a), f.Modulus
+	oldS, s := int64(1), int64(0)
+	for r != 0 {
+		quotient := oldR / r
+		oldR, r = r, oldR-quotient*r
+		oldS, s = s, oldS-quotient*s
+	}
+	if oldR != 1 {
+		return 0, false
+	}
+	return f.Reduce(oldS), true
+}
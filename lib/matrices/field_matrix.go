@@ -0,0 +1,458 @@
+package matrices
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Матрица над произвольным полем. Поле задаёт тип элементов и операции над
+// ними, поэтому одни и те же алгоритмы работают для действительных,
+// комплексных, рациональных чисел и вычетов по простому модулю.
+//
+// Пример:
+//
+//	m, err := NewFieldMatrix[complex128](ComplexField{}, [][]complex128{{1i, 2}, {3, 4}})
+type FieldMatrix[T any] struct {
+	field    Field[T] // Поле, которому принадлежат элементы
+	rows     int      // Количество строк
+	columns  int      // Количество столбцов
+	elements [][]T    // Элементы матрицы
+}
+
+// Элементарное преобразование строк матрицы над полем. Строки нумеруются с
+// нуля.
+type FieldRowOperation[T any] struct {
+	Kind   RowOperationKind // Вид преобразования
+	Row    int              // Изменяемая строка
+	Other  int              // Вторая строка (для перестановки и сложения)
+	Factor T                // Множитель (для умножения и сложения)
+}
+
+// Возвращает запись преобразования, в которой строки нумеруются с единицы.
+//
+// Пример:
+//
+//	R1 <-> R3
+//	R2 * 0.5
+//	R3 + (-2) * R1
+func (o FieldRowOperation[T]) String() string {
+	switch o.Kind {
+	case SwapRows:
+		return fmt.Sprintf("R%d <-> R%d", o.Row+1, o.Other+1)
+	case ScaleRow:
+		return fmt.Sprintf("R%d * %s", o.Row+1, formatElement(o.Factor))
+	case AddRow:
+		return fmt.Sprintf("R%d + %s * R%d", o.Row+1, formatElement(o.Factor), o.Other+1)
+	}
+	return fmt.Sprintf("unknown operation %d", o.Kind)
+}
+
+// Записывает элемент поля, заключая отрицательные числа в скобки.
+func formatElement(element any) string {
+	switch number := element.(type) {
+	case float64:
+		return formatNumber(number)
+	case *big.Rat:
+		return formatRational(number)
+	}
+	text := fmt.Sprint(element)
+	if strings.HasPrefix(text, "-") {
+		return fmt.Sprintf("(%s)", text)
+	}
+	return text
+}
+
+// Проверяет, что кольцо вычетов является полем: модуль не меньше двух и
+// прост. Остальные поля всегда допустимы.
+func validateField[T any](field Field[T]) error {
+	modular, ok := any(field).(ModularField)
+	if !ok {
+		return nil
+	}
+	if modular.Modulus < 2 {
+		return InvalidModulusError(modular.Modulus)
+	}
+	if !big.NewInt(modular.Modulus).ProbablyPrime(0) {
+		return NotPrimeModulusError(modular.Modulus)
+	}
+	return nil
+}
+
+// Возвращает нулевую матрицу над полем.
+func ZeroFieldMatrix[T any](field Field[T], rows int, columns int) FieldMatrix[T] {
+	return FieldMatrix[T]{field, rows, columns, zeroElements(field, rows, columns)}
+}
+
+// Возвращает единичную матрицу порядка n над полем.
+func IdentityFieldMatrix[T any](field Field[T], n int) FieldMatrix[T] {
+	identity := ZeroFieldMatrix(field, n, n)
+	for i := 0; i < n; i++ {
+		identity.elements[i][i] = field.One()
+	}
+	return identity
+}
+
+// Возвращает матрицу над полем.
+//
+// Возвращает ошибку, если в матрице не одинаковое количество столбцов или
+// поле задано кольцом вычетов с модулем меньше двух или составным модулем
+// (в таком кольце метод Гаусса может встретить необратимый ведущий элемент).
+func NewFieldMatrix[T any](field Field[T], elements [][]T) (FieldMatrix[T], error) {
+	if err := validateField(field); err != nil {
+		return FieldMatrix[T]{}, err
+	}
+
+	// Проверка правильности заданной матрицы
+	rows := len(elements)
+	columns := 0
+	for i := 0; i < rows; i++ {
+		if i == 0 {
+			columns = len(elements[i])
+		} else if len(elements[i]) != columns {
+			return FieldMatrix[T]{}, InvalidMatrixError(i + 1)
+		}
+	}
+	return FieldMatrix[T]{field, rows, columns, elements}, nil
+}
+
+// Возвращает количество строк матрицы.
+func (m FieldMatrix[T]) Rows() int {
+	return m.rows
+}
+
+// Возвращает количество столбцов матрицы.
+func (m FieldMatrix[T]) Columns() int {
+	return m.columns
+}
+
+// Возвращает элемент матрицы в заданной строке и столбце (нумерация с нуля).
+func (m FieldMatrix[T]) At(row int, column int) T {
+	return m.elements[row][column]
+}
+
+// Возвращает поле, которому принадлежат элементы матрицы.
+func (m FieldMatrix[T]) Field() Field[T] {
+	return m.field
+}
+
+// Умножает каждый элемент матрицы на заданное число.
+func (m *FieldMatrix[T]) MultiplyByNumber(number T) {
+	scaleElements(m.field, m.elements, number)
+}
+
+// Возвращает транспонированную матрицу.
+func (m FieldMatrix[T]) Transpose() FieldMatrix[T] {
+	return FieldMatrix[T]{m.field, m.columns, m.rows, transposeElements(m.elements, m.rows, m.columns)}
+}
+
+// Прибавляет к каждому элементу матрицы элементы другой матрицы.
+//
+// Если аргумент negative равен true, то будет произведено вычитание матриц.
+func (m *FieldMatrix[T]) AddMatrix(other FieldMatrix[T], negative bool) error {
+	// У матриц должны быть равно количество строк и столбцов
+	if m.rows != other.rows || m.columns != other.columns {
+		return NotSameSizeError(m.rows, m.columns, other.rows, other.columns)
+	}
+	addElements(m.field, m.elements, other.elements, negative)
+	return nil
+}
+
+// Возвращает матрицу, являющуюся результатом умножения текущей матрицы на
+// другую заданную.
+//
+// Возвращает ошибку, если матрицы нельзя перемножить.
+func (m FieldMatrix[T]) MultiplyMatrix(other FieldMatrix[T]) (FieldMatrix[T], error) {
+	// Число столбцов первой матрицы должно совпадать с числом строк второй
+	if m.columns != other.rows {
+		return FieldMatrix[T]{}, UnableToMultiplyError(m.columns, other.rows)
+	}
+	elements := multiplyElements(m.field, m.elements, other.elements, m.rows, m.columns, other.columns)
+	return FieldMatrix[T]{m.field, m.rows, other.columns, elements}, nil
+}
+
+// Возвращает определитель квадратной матрицы.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m FieldMatrix[T]) Determinator() (T, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return m.field.Zero(), NotSquareMatrixError()
+	}
+	return determinatorElements(m.field, m.elements, m.rows), nil
+}
+
+// Возвращает обратную матрицу, найденную методом Гаусса-Жордана.
+//
+// Возвращает ошибку, если матрица не квадратная или вырожденная.
+func (m FieldMatrix[T]) Inverse() (FieldMatrix[T], error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return FieldMatrix[T]{}, NotSquareMatrixError()
+	}
+	elements, err := inverseElements(m.field, m.elements, m.rows)
+	if err != nil {
+		return FieldMatrix[T]{}, err
+	}
+	return FieldMatrix[T]{m.field, m.rows, m.columns, elements}, nil
+}
+
+// Возвращает ступенчатый вид матрицы и последовательность выполненных
+// элементарных преобразований строк.
+func (m FieldMatrix[T]) RowEchelon() (FieldMatrix[T], []FieldRowOperation[T]) {
+	elements := cloneElements(m.elements)
+	_, operations := rowReduceElements(m.field, elements, m.columns, false)
+	return FieldMatrix[T]{m.field, m.rows, m.columns, elements}, operations
+}
+
+// Возвращает упрощённый ступенчатый вид матрицы и последовательность
+// выполненных элементарных преобразований строк.
+func (m FieldMatrix[T]) RREF() (FieldMatrix[T], []FieldRowOperation[T]) {
+	elements := cloneElements(m.elements)
+	_, operations := rowReduceElements(m.field, elements, m.columns, true)
+	return FieldMatrix[T]{m.field, m.rows, m.columns, elements}, operations
+}
+
+// Применяет к матрице элементарное преобразование строк.
+func (m *FieldMatrix[T]) ApplyRowOperation(operation FieldRowOperation[T]) {
+	applyRowOperationElements(m.field, m.elements, operation)
+}
+
+// Возвращает элементы нулевой матрицы.
+func zeroElements[T any](ring Ring[T], rows int, columns int) [][]T {
+	elements := make([][]T, rows)
+	for i := range elements {
+		elements[i] = make([]T, columns)
+		for j := range elements[i] {
+			elements[i][j] = ring.Zero()
+		}
+	}
+	return elements
+}
+
+// Возвращает копию элементов. Сами элементы не копируются, поэтому
+// операции кольца не должны изменять свои аргументы.
+func cloneElements[T any](elements [][]T) [][]T {
+	result := make([][]T, len(elements))
+	for i := range elements {
+		result[i] = make([]T, len(elements[i]))
+		copy(result[i], elements[i])
+	}
+	return result
+}
+
+// Умножает каждый элемент на заданное число.
+func scaleElements[T any](ring Ring[T], elements [][]T, number T) {
+	for i := range elements {
+		for j := range elements[i] {
+			elements[i][j] = ring.Mul(elements[i][j], number)
+		}
+	}
+}
+
+// Возвращает транспонированные элементы.
+func transposeElements[T any](elements [][]T, rows int, columns int) [][]T {
+	transposed := make([][]T, columns)
+	for j := range transposed {
+		transposed[j] = make([]T, rows)
+	}
+	for i := 0; i < rows; i++ {
+		for j := 0; j < columns; j++ {
+			transposed[j][i] = elements[i][j]
+		}
+	}
+	return transposed
+}
+
+// Прибавляет (или вычитает, если negative равен true) элементы другой
+// матрицы того же размера.
+func addElements[T any](ring Ring[T], elements [][]T, other [][]T, negative bool) {
+	for i := range elements {
+		for j := range elements[i] {
+			if negative {
+				elements[i][j] = ring.Sub(elements[i][j], other[i][j])
+			} else {
+				elements[i][j] = ring.Add(elements[i][j], other[i][j])
+			}
+		}
+	}
+}
+
+// Возвращает произведение матриц размеров rows x inner и inner x columns.
+func multiplyElements[T any](ring Ring[T], a [][]T, b [][]T, rows int, inner int, columns int) [][]T {
+	result := zeroElements(ring, rows, columns)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < columns; j++ {
+			for k := 0; k < inner; k++ {
+				result[i][j] = ring.Add(result[i][j], ring.Mul(a[i][k], b[k][j]))
+			}
+		}
+	}
+	return result
+}
+
+// Применяет к элементам элементарное преобразование строк.
+func applyRowOperationElements[T any](ring Ring[T], elements [][]T, operation FieldRowOperation[T]) {
+	row := elements[operation.Row]
+	switch operation.Kind {
+	case SwapRows:
+		// Меняем местами сами элементы, а не срезы строк, так как срезы могут
+		// ссылаться на общее хранилище матрицы
+		other := elements[operation.Other]
+		for j := range row {
+			row[j], other[j] = other[j], row[j]
+		}
+	case ScaleRow:
+		for j := range row {
+			row[j] = ring.Mul(row[j], operation.Factor)
+		}
+	case AddRow:
+		for j := range row {
+			row[j] = ring.Add(row[j], ring.Mul(operation.Factor, elements[operation.Other][j]))
+		}
+	}
+}
+
+// Приводит элементы к ступенчатому виду методом Гаусса. Если reduced равен
+// true, элементы приводятся к упрощённому ступенчатому виду: ведущие
+// элементы равны единице, а остальные элементы их столбцов - нулю.
+//
+// В полях с приближённой арифметикой ведущим выбирается наибольший по модулю
+// элемент, а элементы, меньшие Epsilon относительно наибольшего элемента
+// матрицы, считаются нулями. В остальных полях ведущим выбирается первый
+// ненулевой элемент.
+//
+// Изменяет переданные элементы. Возвращает номера столбцов с ведущими
+// элементами и выполненные преобразования.
+func rowReduceElements[T any](field Field[T], elements [][]T, columns int, reduced bool) ([]int, []FieldRowOperation[T]) {
+	return rowReduceElementsTolerance(field, elements, columns, reduced, Epsilon)
+}
+
+// Приводит элементы к ступенчатому виду так же, как rowReduceElements, но в
+// полях с приближённой арифметикой считает нулями элементы, меньшие
+// tolerance относительно наибольшего элемента матрицы.
+func rowReduceElementsTolerance[T any](field Field[T], elements [][]T, columns int, reduced bool, tolerance float64) ([]int, []FieldRowOperation[T]) {
+	rows := len(elements)
+	approximate, isApproximate := field.(ApproximateField[T])
+
+	// Числа, меньшие порога по модулю, считаются нулями
+	threshold := 0.0
+	if isApproximate {
+		for i := 0; i < rows; i++ {
+			for j := 0; j < columns; j++ {
+				threshold = max(threshold, approximate.Abs(elements[i][j]))
+			}
+		}
+		threshold *= tolerance
+	}
+
+	pivots := []int{}
+	operations := []FieldRowOperation[T]{}
+	apply := func(operation FieldRowOperation[T]) {
+		applyRowOperationElements(field, elements, operation)
+		operations = append(operations, operation)
+	}
+
+	row := 0
+	for column := 0; column < columns && row < rows; column++ {
+		// Выбираем ведущий элемент
+		pivot := -1
+		if isApproximate {
+			pivot = row
+			for i := row + 1; i < rows; i++ {
+				if approximate.Abs(elements[i][column]) > approximate.Abs(elements[pivot][column]) {
+					pivot = i
+				}
+			}
+			if approximate.Abs(elements[pivot][column]) <= threshold {
+				pivot = -1
+			}
+		} else {
+			for i := row; i < rows; i++ {
+				if !field.IsZero(elements[i][column]) {
+					pivot = i
+					break
+				}
+			}
+		}
+		if pivot == -1 {
+			// Ведущего элемента нет, столбец соответствует свободной переменной
+			for i := row; i < rows; i++ {
+				elements[i][column] = field.Zero()
+			}
+			continue
+		}
+		if pivot != row {
+			apply(FieldRowOperation[T]{SwapRows, row, pivot, field.Zero()})
+		}
+
+		if reduced && !field.IsZero(field.Sub(elements[row][column], field.One())) {
+			// Делим строку на ведущий элемент
+			apply(FieldRowOperation[T]{ScaleRow, row, row, field.Div(field.One(), elements[row][column])})
+			elements[row][column] = field.One()
+		}
+
+		// Обнуляем элементы под ведущим (и над ним для упрощённого вида)
+		start := row + 1
+		if reduced {
+			start = 0
+		}
+		for i := start; i < rows; i++ {
+			if i == row || field.IsZero(elements[i][column]) {
+				continue
+			}
+			factor := field.Neg(field.Div(elements[i][column], elements[row][column]))
+			apply(FieldRowOperation[T]{AddRow, i, row, factor})
+			elements[i][column] = field.Zero()
+		}
+
+		pivots = append(pivots, column)
+		row++
+	}
+
+	return pivots, operations
+}
+
+// Возвращает определитель квадратной матрицы порядка n: произведение
+// диагональных элементов ступенчатого вида, знак которого меняется при
+// каждой перестановке строк.
+func determinatorElements[T any](field Field[T], elements [][]T, n int) T {
+	echelon := cloneElements(elements)
+	_, operations := rowReduceElements(field, echelon, n, false)
+
+	determinator := field.One()
+	for _, operation := range operations {
+		if operation.Kind == SwapRows {
+			determinator = field.Neg(determinator)
+		}
+	}
+	for i := 0; i < n; i++ {
+		determinator = field.Mul(determinator, echelon[i][i])
+	}
+	return determinator
+}
+
+// Возвращает элементы обратной матрицы порядка n, приводя расширенную
+// матрицу [A|E] к виду [E|A⁻¹].
+//
+// Возвращает ошибку, если матрица вырожденная.
+func inverseElements[T any](field Field[T], elements [][]T, n int) ([][]T, error) {
+	augmented := zeroElements(field, n, 2*n)
+	for i := 0; i < n; i++ {
+		copy(augmented[i], elements[i])
+		augmented[i][n+i] = field.One()
+	}
+
+	pivots, _ := rowReduceElements(field, augmented, 2*n, true)
+	for k := 0; k < n; k++ {
+		if k >= len(pivots) || pivots[k] != k {
+			return nil, SingularMatrixError(k + 1)
+		}
+	}
+
+	inverse := make([][]T, n)
+	for i := 0; i < n; i++ {
+		inverse[i] = augmented[i][n:]
+	}
+	return inverse, nil
+}
@@ -0,0 +1,175 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/cmplx"
+	"testing"
+)
+
+// Одинаковый определитель целочисленной матрицы во всех полях
+func TestFieldMatrixDeterminatorAcrossFields(t *testing.T) {
+	tests := []struct {
+		elements [][]int64
+		want     int64
+	}{
+		{[][]int64{{11, -3}, {-15, -2}}, -67},
+		{[][]int64{{1, -2, 3}, {4, 0, 6}, {-7, 8, 9}}, 204},
+		{[][]int64{{0, 1, 2, 3}, {1, 0, 1, 2}, {2, 1, 0, 1}, {3, 2, 1, 0}}, -12},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			n := len(tt.elements)
+			reals := make([][]float64, n)
+			complexes := make([][]complex128, n)
+			rationals := make([][]*big.Rat, n)
+			for i := range tt.elements {
+				reals[i] = make([]float64, n)
+				complexes[i] = make([]complex128, n)
+				rationals[i] = make([]*big.Rat, n)
+				for j, element := range tt.elements[i] {
+					reals[i][j] = float64(element)
+					complexes[i][j] = complex(float64(element), 0)
+					rationals[i][j] = big.NewRat(element, 1)
+				}
+			}
+
+			real, _ := NewFieldMatrix[float64](RealField{}, reals)
+			if got, _ := real.Determinator(); math.Abs(got-float64(tt.want)) > 1e-9 {
+				t.Errorf("real: got %g, want %d", got, tt.want)
+			}
+
+			complexMatrix, _ := NewFieldMatrix[complex128](ComplexField{}, complexes)
+			if got, _ := complexMatrix.Determinator(); cmplx.Abs(got-complex(float64(tt.want), 0)) > 1e-9 {
+				t.Errorf("complex: got %g, want %d", got, tt.want)
+			}
+
+			rational, _ := NewFieldMatrix[*big.Rat](RationalField{}, rationals)
+			if got, _ := rational.Determinator(); got.Cmp(big.NewRat(tt.want, 1)) != 0 {
+				t.Errorf("rational: got %s, want %d", got.RatString(), tt.want)
+			}
+
+			field := ModularField{101}
+			modular, _ := NewFieldMatrix[int64](field, tt.elements)
+			if got, _ := modular.Determinator(); got != field.Reduce(tt.want) {
+				t.Errorf("modular: got %d, want %d", got, field.Reduce(tt.want))
+			}
+		})
+	}
+}
+
+// Действия с комплексными матрицами
+func TestComplexFieldMatrix(t *testing.T) {
+	matrix, err := NewFieldMatrix[complex128](ComplexField{}, [][]complex128{{1i, 2}, {3, 4}})
+	if err != nil {
+		t.Fatalf("got an error while initializing FieldMatrix: %v", err)
+	}
+
+	determinator, _ := matrix.Determinator()
+	if cmplx.Abs(determinator-complex(-6, 4)) > 1e-9 {
+		t.Errorf("got %g, want (-6+4i)", determinator)
+	}
+
+	inverse, err := matrix.Inverse()
+	if err != nil {
+		t.Fatalf("got an error while inverting: %v", err)
+	}
+	product, _ := matrix.MultiplyMatrix(inverse)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			want := complex(0, 0)
+			if i == j {
+				want = 1
+			}
+			if cmplx.Abs(product.At(i, j)-want) > 1e-9 {
+				t.Errorf("A*A⁻¹ = %v", product.elements)
+			}
+		}
+	}
+
+	transposed := matrix.Transpose()
+	if transposed.At(0, 1) != 3 || transposed.Rows() != 2 || transposed.Columns() != 2 {
+		t.Errorf("got %v", transposed.elements)
+	}
+
+	matrix.MultiplyByNumber(1i)
+	if err := matrix.AddMatrix(transposed, false); err != nil {
+		t.Fatalf("got an error while adding: %v", err)
+	}
+	if want := "[[(-1+1i) (3+2i)] [(2+3i) (4+4i)]]"; fmt.Sprintf("%v", matrix.elements) != want {
+		t.Errorf("got %v, want %v", matrix.elements, want)
+	}
+}
+
+// Матрицы над полем вычетов по простому модулю
+func TestModularFieldMatrix(t *testing.T) {
+	field := ModularField{7}
+	matrix, _ := NewFieldMatrix[int64](field, [][]int64{{1, 2}, {3, 4}})
+
+	inverse, err := matrix.Inverse()
+	if err != nil {
+		t.Fatalf("got an error while inverting: %v", err)
+	}
+	if want := "[[5 1] [5 3]]"; fmt.Sprintf("%v", inverse.elements) != want {
+		t.Errorf("got %v, want %v", inverse.elements, want)
+	}
+
+	singular, _ := NewFieldMatrix[int64](field, [][]int64{{1, 2}, {3, 6}})
+	if _, err := singular.Inverse(); err == nil || err.Error() != SingularMatrixError(2).Error() {
+		t.Errorf("got %v, want %v", err, SingularMatrixError(2))
+	}
+
+	reduced, operations := matrix.RREF()
+	if want := "[[1 0] [0 1]]"; fmt.Sprintf("%v", reduced.elements) != want {
+		t.Errorf("got %v, want %v", reduced.elements, want)
+	}
+	if want := "[R2 + 4 * R1 R2 * 3 R1 + 5 * R2]"; fmt.Sprintf("%v", operations) != want {
+		t.Errorf("got %v, want %v", operations, want)
+	}
+
+	echelon, _ := matrix.RowEchelon()
+	if want := "[[1 2] [0 5]]"; fmt.Sprintf("%v", echelon.elements) != want {
+		t.Errorf("got %v, want %v", echelon.elements, want)
+	}
+}
+
+// Ошибки при создании и умножении матриц над полем
+func TestFieldMatrixErrors(t *testing.T) {
+	if _, err := NewFieldMatrix[float64](RealField{}, [][]float64{{1, 2}, {3}}); err == nil || err.Error() != InvalidMatrixError(2).Error() {
+		t.Errorf("got %v, want %v", err, InvalidMatrixError(2))
+	}
+
+	// Кольцо вычетов должно быть полем
+	fields := []struct {
+		modulus int64
+		wantErr error
+	}{
+		{0, InvalidModulusError(0)},
+		{1, InvalidModulusError(1)},
+		{-7, InvalidModulusError(-7)},
+		{26, NotPrimeModulusError(26)},
+	}
+	for _, tt := range fields {
+		if _, err := NewFieldMatrix[int64](ModularField{tt.modulus}, [][]int64{{2, 1}, {1, 1}}); err == nil || err.Error() != tt.wantErr.Error() {
+			t.Errorf("got %v, want %v", err, tt.wantErr)
+		}
+	}
+
+	matrix1 := IdentityFieldMatrix[float64](RealField{}, 2)
+	matrix2 := ZeroFieldMatrix[float64](RealField{}, 3, 2)
+	if _, err := matrix1.MultiplyMatrix(matrix2); err == nil || err.Error() != UnableToMultiplyError(2, 3).Error() {
+		t.Errorf("got %v, want %v", err, UnableToMultiplyError(2, 3))
+	}
+	if err := matrix1.AddMatrix(matrix2, false); err == nil || err.Error() != NotSameSizeError(2, 2, 3, 2).Error() {
+		t.Errorf("got %v, want %v", err, NotSameSizeError(2, 2, 3, 2))
+	}
+	if _, err := matrix2.Determinator(); err == nil || err.Error() != NotSquareMatrixError().Error() {
+		t.Errorf("got %v, want %v", err, NotSquareMatrixError())
+	}
+	if _, err := matrix2.Inverse(); err == nil || err.Error() != NotSquareMatrixError().Error() {
+		t.Errorf("got %v, want %v", err, NotSquareMatrixError())
+	}
+}
@@ -0,0 +1,75 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Операции в кольце вычетов
+func TestModularField(t *testing.T) {
+	field := ModularField{7}
+	tests := []struct {
+		name string
+		got  int64
+		want int64
+	}{
+		{"reduce", field.Reduce(-15), 6},
+		{"add", field.Add(5, 4), 2},
+		{"sub", field.Sub(2, 5), 4},
+		{"mul", field.Mul(3, 5), 1},
+		{"div", field.Div(1, 3), 5},
+		{"neg", field.Neg(3), 4},
+		{"one", field.One(), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %d, want %d", tt.got, tt.want)
+			}
+		})
+	}
+
+	// Произведение больших вычетов не должно переполняться
+	large := ModularField{math.MaxInt64}
+	if got := large.Mul(math.MaxInt64-1, math.MaxInt64-1); got != 1 {
+		t.Errorf("got %d, want 1", got)
+	}
+	if got := large.Add(math.MaxInt64-1, math.MaxInt64-1); got != math.MaxInt64-2 {
+		t.Errorf("got %d, want %d", got, int64(math.MaxInt64-2))
+	}
+}
+
+// Обратный элемент в кольце вычетов
+func TestModularFieldInverse(t *testing.T) {
+	tests := []struct {
+		modulus int64
+		a       int64
+		want    int64
+		wantOk  bool
+	}{
+		{7, 3, 5, true},
+		{26, 3, 9, true},
+		{26, 13, 0, false},
+		{26, 0, 0, false},
+		{11, -1, 10, true},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%d^-1 mod %d", tt.a, tt.modulus)
+		t.Run(testname, func(t *testing.T) {
+			got, ok := ModularField{tt.modulus}.Inverse(tt.a)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("got %d, %v, want %d, %v", got, ok, tt.want, tt.wantOk)
+			}
+			if invertible := (ModularField{tt.modulus}).IsInvertible(tt.a); invertible != tt.wantOk {
+				t.Errorf("got IsInvertible %v, want %v", invertible, tt.wantOk)
+			}
+			// Деление на необратимый элемент не должно молча возвращать ноль
+			if panicked := panics(func() { ModularField{tt.modulus}.Div(1, tt.a) }); panicked == tt.wantOk {
+				t.Errorf("got panic %v for Div(1, %d)", panicked, tt.a)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package matrices
+
+import "math"
+
+// Возвращает обратную матрицу, найденную методом Гаусса-Жордана: расширенная
+// матрица [A|E] элементарными преобразованиями строк приводится к виду
+// [E|A⁻¹].
+//
+// Возвращает ошибку, если матрица не квадратная, вырожденная или близка к
+// вырожденной.
+func (m Matrix) Inverse() (Matrix, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return Matrix{}, NotSquareMatrixError()
+	}
+
+	n := m.rows
+	a := m.clone()
+	inverse := IdentityMatrix(n)
+
+	scale := 0.0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			scale = max(scale, math.Abs(m.row(i)[j]))
+		}
+	}
+	threshold := Epsilon * scale
+
+	for k := 0; k < n; k++ {
+		// Выбираем ведущий элемент, наибольший по модулю в столбце
+		pivot := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(a.row(i)[k]) > math.Abs(a.row(pivot)[k]) {
+				pivot = i
+			}
+		}
+		if a.row(pivot)[k] == 0 {
+			return Matrix{}, SingularMatrixError(k + 1)
+		}
+		if math.Abs(a.row(pivot)[k]) <= threshold {
+			return Matrix{}, NearSingularMatrixError(k+1, a.row(pivot)[k])
+		}
+		a.swapRows(pivot, k)
+		inverse.swapRows(pivot, k)
+
+		// Делим строку на ведущий элемент
+		factor := a.row(k)[k]
+		for j := 0; j < n; j++ {
+			a.row(k)[j] /= factor
+			inverse.row(k)[j] /= factor
+		}
+
+		// Обнуляем остальные элементы столбца, в том числе над ведущим
+		for i := 0; i < n; i++ {
+			if i == k || a.row(i)[k] == 0 {
+				continue
+			}
+			factor := a.row(i)[k]
+			for j := 0; j < n; j++ {
+				a.row(i)[j] -= factor * a.row(k)[j]
+				inverse.row(i)[j] -= factor * inverse.row(k)[j]
+			}
+		}
+	}
+
+	return inverse, nil
+}
+
+// Возвращает обратную матрицу, найденную через присоединённую матрицу:
+// A⁻¹ = adj(A) / det A, где adj(A) - транспонированная матрица алгебраических
+// дополнений.
+//
+// Работает намного медленнее метода Гаусса-Жордана и предназначен для
+// проверки результатов по формуле из учебника.
+//
+// Возвращает ошибку, если матрица не квадратная или её определитель равен
+// нулю.
+func (m Matrix) InverseAdjugate() (Matrix, error) {
+	determinator, err := m.Determinator()
+	if err != nil {
+		return Matrix{}, err
+	}
+	if determinator == 0 {
+		return Matrix{}, ZeroDeterminatorError()
+	}
+
+	inverse, _ := m.Adjugate()
+	inverse.DivideByNumber(determinator)
+
+	return inverse, nil
+}
@@ -0,0 +1,81 @@
+package matrices
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Нахождение обратной матрицы двумя способами
+func TestMatrixInverse(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		want     [][]float64
+	}{
+		{[][]float64{}, [][]float64{}},
+		{[][]float64{{4}}, [][]float64{{0.25}}},
+		{[][]float64{{4, 7}, {2, 6}}, [][]float64{{0.6, -0.7}, {-0.2, 0.4}}},
+		{[][]float64{{0, 1}, {1, 0}}, [][]float64{{0, 1}, {1, 0}}},
+		{[][]float64{{2, 5, 4}, {1, 3, 2}, {2, 10, 9}}, [][]float64{{1.4, -1, -0.4}, {-1, 2, 0}, {0.8, -2, 0.2}}},
+		{[][]float64{{1, 0, 0, 0}, {2, 1, 0, 0}, {0, 0, 2, 0}, {0, 0, 1, 1}}, [][]float64{{1, 0, 0, 0}, {-2, 1, 0, 0}, {0, 0, 0.5, 0}, {0, 0, -0.5, 1}}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing Matrix: %v", err)
+			}
+
+			got, err := matrix.Inverse()
+			if err != nil {
+				t.Fatalf("got an error while inverting Matrix: %v", err)
+			}
+			if !approximatelyEqual(got.rowSlices(), tt.want, 1e-9) {
+				t.Errorf("Gauss-Jordan: got %v, want %v", got.rowSlices(), tt.want)
+			}
+
+			got, err = matrix.InverseAdjugate()
+			if err != nil {
+				t.Fatalf("got an error while inverting Matrix: %v", err)
+			}
+			if !approximatelyEqual(got.rowSlices(), tt.want, 1e-9) {
+				t.Errorf("adjugate: got %v, want %v", got.rowSlices(), tt.want)
+			}
+
+			// Исходная матрица не должна измениться
+			if fmt.Sprintf("%v", matrix.rowSlices()) != fmt.Sprintf("%v", tt.elements) {
+				t.Errorf("matrix changed: %v", matrix.rowSlices())
+			}
+		})
+	}
+}
+
+// Ошибки при нахождении обратной матрицы
+func TestMatrixInverseErrors(t *testing.T) {
+	tests := []struct {
+		elements     [][]float64
+		want         error
+		wantAdjugate error
+	}{
+		{[][]float64{{1, 2, 3}, {4, 5, 6}}, NotSquareMatrixError(), NotSquareMatrixError()},
+		{[][]float64{{1, 2}, {2, 4}}, SingularMatrixError(2), ZeroDeterminatorError()},
+		{[][]float64{{0, 0}, {0, 0}}, SingularMatrixError(1), ZeroDeterminatorError()},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing Matrix: %v", err)
+			}
+			if _, err := matrix.Inverse(); err == nil || err.Error() != tt.want.Error() {
+				t.Errorf("Gauss-Jordan: got %v, want %v", err, tt.want)
+			}
+			if _, err := matrix.InverseAdjugate(); err == nil || err.Error() != tt.wantAdjugate.Error() {
+				t.Errorf("adjugate: got %v, want %v", err, tt.wantAdjugate)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package matrices
+
+import "math"
+
+// Способ решения задачи наименьших квадратов.
+type LeastSquaresMethod byte
+
+const (
+	// QR-разложение: Rx = Qᵀb. Устойчиво к ошибкам округления.
+	LeastSquaresQR LeastSquaresMethod = iota
+	// Нормальные уравнения: AᵀAx = Aᵀb. Проще, но число обусловленности
+	// AᵀA равно квадрату числа обусловленности A.
+	LeastSquaresNormal
+)
+
+// Решение переопределённой системы Ax ≈ b по методу наименьших квадратов:
+// вектор x, для которого длина невязки b - Ax наименьшая.
+//
+// Если число обусловленности матрицы A слишком велико, Warning содержит
+// IllConditionedError. Для нормальных уравнений учитывается число
+// обусловленности AᵀA, равное квадрату числа обусловленности A.
+type LeastSquaresSolution struct {
+	X            []float64 // Решение
+	Residuals    []float64 // Невязка b - Ax
+	ResidualNorm float64   // Длина невязки ||b - Ax||
+	Condition    float64   // Спектральное число обусловленности A
+	Warning      error     // Предупреждение о неточности решения (nil, если его нет)
+}
+
+// Многочлен, приближающий точки по методу наименьших квадратов.
+type PolynomialFit struct {
+	Coefficients []float64 // Коэффициенты в порядке возрастания степеней
+	Residuals    []float64 // Отклонения yᵢ - p(xᵢ)
+	ResidualNorm float64   // Длина вектора отклонений
+}
+
+// Решает систему Ax ≈ b по методу наименьших квадратов с помощью
+// QR-разложения.
+//
+// Возвращает ошибку, если длина b не равна количеству строк A или столбцы A
+// линейно зависимы.
+func LeastSquares(a Matrix, b []float64) (LeastSquaresSolution, error) {
+	return LeastSquaresBy(a, b, LeastSquaresQR)
+}
+
+// Решает систему Ax ≈ b по методу наименьших квадратов заданным способом.
+//
+// Возвращает ошибку, если длина b не равна количеству строк A, способ
+// неизвестен или столбцы A линейно зависимы (для нормальных уравнений -
+// ошибку вырожденности матрицы AᵀA).
+func LeastSquaresBy(a Matrix, b []float64, method LeastSquaresMethod) (LeastSquaresSolution, error) {
+	if len(b) != a.rows {
+		return LeastSquaresSolution{}, NotSameSizeError(a.rows, 1, len(b), 1)
+	}
+
+	var x []float64
+	var err error
+	switch method {
+	case LeastSquaresQR:
+		x, err = leastSquaresQR(a, b)
+	case LeastSquaresNormal:
+		x, err = leastSquaresNormal(a, b)
+	default:
+		return LeastSquaresSolution{}, UnknownMethodError(byte(method))
+	}
+	if err != nil {
+		return LeastSquaresSolution{}, err
+	}
+
+	// Невязка b - Ax
+	residuals := make([]float64, a.rows)
+	norm := 0.0
+	for i := 0; i < a.rows; i++ {
+		residuals[i] = b[i]
+		for j := 0; j < a.columns; j++ {
+			residuals[i] -= a.row(i)[j] * x[j]
+		}
+		norm = math.Hypot(norm, residuals[i])
+	}
+
+	condition, err := a.ConditionNumber()
+	if err != nil {
+		condition = math.Inf(1)
+	}
+	warning := conditionWarning(condition)
+	if method == LeastSquaresNormal {
+		warning = conditionWarning(condition * condition)
+	}
+	return LeastSquaresSolution{x, residuals, norm, condition, warning}, nil
+}
+
+// Возвращает матрицу Вандермонда для многочлена заданной степени: i-я
+// строка равна 1, xᵢ, xᵢ², ..., xᵢ^degree.
+func VandermondeMatrix(x []float64, degree int) Matrix {
+	vandermonde := ZeroMatrix(len(x), degree+1)
+	for i := range x {
+		power := 1.0
+		for j := 0; j <= degree; j++ {
+			vandermonde.row(i)[j] = power
+			power *= x[i]
+		}
+	}
+	return vandermonde
+}
+
+// Возвращает многочлен заданной степени, приближающий точки (xᵢ, yᵢ) по
+// методу наименьших квадратов. Степень 1 соответствует линейной регрессии
+// y = c[0] + c[1]x.
+//
+// Возвращает ошибку, если степень отрицательная, длины x и y не равны или
+// различных значений x меньше, чем коэффициентов многочлена.
+func FitPolynomial(x []float64, y []float64, degree int) (PolynomialFit, error) {
+	if degree < 0 {
+		return PolynomialFit{}, InvalidDegreeError(degree)
+	}
+	if len(x) != len(y) {
+		return PolynomialFit{}, NotSameSizeError(len(x), 1, len(y), 1)
+	}
+
+	solution, err := LeastSquares(VandermondeMatrix(x, degree), y)
+	if err != nil {
+		return PolynomialFit{}, err
+	}
+	return PolynomialFit{solution.X, solution.Residuals, solution.ResidualNorm}, nil
+}
+
+// Решает задачу наименьших квадратов с помощью QR-разложения: так как Q
+// сохраняет длины, достаточно решить треугольную систему Rx = Qᵀb.
+func leastSquaresQR(a Matrix, b []float64) ([]float64, error) {
+	// В пространстве размерности m любые m+1 векторов линейно зависимы
+	if a.rows < a.columns {
+		return nil, LinearlyDependentError(a.rows + 1)
+	}
+
+	qr := a.QR()
+	scale := 0.0
+	for i := 0; i < qr.R.rows; i++ {
+		for j := 0; j < qr.R.columns; j++ {
+			scale = max(scale, math.Abs(qr.R.row(i)[j]))
+		}
+	}
+
+	// y = Qᵀb
+	n := a.columns
+	y := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < a.rows; j++ {
+			y[i] += qr.Q.row(j)[i] * b[j]
+		}
+	}
+
+	// Обратная подстановка
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		if math.Abs(qr.R.row(i)[i]) <= Epsilon*scale {
+			return nil, LinearlyDependentError(i + 1)
+		}
+		x[i] = y[i]
+		for j := i + 1; j < n; j++ {
+			x[i] -= qr.R.row(i)[j] * x[j]
+		}
+		x[i] /= qr.R.row(i)[i]
+	}
+	return x, nil
+}
+
+// Решает задачу наименьших квадратов с помощью нормальных уравнений
+// AᵀAx = Aᵀb.
+func leastSquaresNormal(a Matrix, b []float64) ([]float64, error) {
+	transposed := a.Transpose()
+	gram, _ := transposed.MultiplyMatrix(a)
+
+	// Aᵀb
+	column := make([][]float64, len(b))
+	for i := range b {
+		column[i] = []float64{b[i]}
+	}
+	right, _ := transposed.MultiplyMatrix(matrixFromRows(len(b), 1, column))
+	rhs := make([]float64, a.columns)
+	for i := range rhs {
+		rhs[i] = right.row(i)[0]
+	}
+
+	lu, err := gram.LU()
+	if err != nil {
+		return nil, err
+	}
+	return lu.Solve(rhs)
+}
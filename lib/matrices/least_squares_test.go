@@ -0,0 +1,127 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Метод наименьших квадратов обоими способами
+func TestLeastSquares(t *testing.T) {
+	tests := []struct {
+		a            [][]float64
+		b            []float64
+		x            []float64
+		residuals    []float64
+		residualNorm float64
+	}{
+		// Точки лежат на прямой y = 1 + 2x
+		{[][]float64{{1, 0}, {1, 1}, {1, 2}, {1, 3}}, []float64{1, 3, 5, 7}, []float64{1, 2}, []float64{0, 0, 0, 0}, 0},
+		{[][]float64{{1, 0}, {1, 1}, {1, 2}}, []float64{6, 0, 0}, []float64{5, -3}, []float64{1, -2, 1}, math.Sqrt(6)},
+		{[][]float64{{2, 1}, {1, 3}}, []float64{3, 5}, []float64{0.8, 1.4}, []float64{0, 0}, 0},
+		{[][]float64{{1}, {1}, {1}}, []float64{1, 2, 6}, []float64{3}, []float64{-2, -1, 3}, math.Sqrt(14)},
+	}
+
+	for _, tt := range tests {
+		for _, method := range []LeastSquaresMethod{LeastSquaresQR, LeastSquaresNormal} {
+			testname := fmt.Sprintf("%v %v method=%d", tt.a, tt.b, method)
+			t.Run(testname, func(t *testing.T) {
+				a, _ := NewMatrix(tt.a)
+				solution, err := LeastSquaresBy(a, tt.b, method)
+				if err != nil {
+					t.Fatalf("got an error while solving: %v", err)
+				}
+				if !approximatelyEqual([][]float64{solution.X}, [][]float64{tt.x}, 1e-9) {
+					t.Errorf("got x = %v, want %v", solution.X, tt.x)
+				}
+				if !approximatelyEqual([][]float64{solution.Residuals}, [][]float64{tt.residuals}, 1e-9) {
+					t.Errorf("got residuals %v, want %v", solution.Residuals, tt.residuals)
+				}
+				if math.Abs(solution.ResidualNorm-tt.residualNorm) > 1e-9 {
+					t.Errorf("got residual norm %g, want %g", solution.ResidualNorm, tt.residualNorm)
+				}
+			})
+		}
+	}
+}
+
+// Ошибки метода наименьших квадратов
+func TestLeastSquaresErrors(t *testing.T) {
+	dependent, _ := NewMatrix([][]float64{{1, 2}, {2, 4}, {3, 6}})
+	wide, _ := NewMatrix([][]float64{{1, 2, 3}, {4, 5, 6}})
+	tests := []struct {
+		a       Matrix
+		b       []float64
+		method  LeastSquaresMethod
+		wantErr error
+	}{
+		{dependent, []float64{1, 2}, LeastSquaresQR, NotSameSizeError(3, 1, 2, 1)},
+		{dependent, []float64{1, 2, 3}, 2, UnknownMethodError(2)},
+		{dependent, []float64{1, 2, 3}, LeastSquaresQR, LinearlyDependentError(2)},
+		{dependent, []float64{1, 2, 3}, LeastSquaresNormal, SingularMatrixError(2)},
+		{wide, []float64{1, 2}, LeastSquaresQR, LinearlyDependentError(3)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v %v method=%d", tt.a.rowSlices(), tt.b, tt.method)
+		t.Run(testname, func(t *testing.T) {
+			_, err := LeastSquaresBy(tt.a, tt.b, tt.method)
+			if err == nil || err.Error() != tt.wantErr.Error() {
+				t.Errorf("got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// Матрица Вандермонда
+func TestVandermondeMatrix(t *testing.T) {
+	got := VandermondeMatrix([]float64{1, 2, 3}, 2)
+	want := "[[1 1 1] [1 2 4] [1 3 9]]"
+	if fmt.Sprintf("%v", got.rowSlices()) != want {
+		t.Errorf("got %v, want %v", got.rowSlices(), want)
+	}
+}
+
+// Приближение точек многочленом
+func TestFitPolynomial(t *testing.T) {
+	tests := []struct {
+		x            []float64
+		y            []float64
+		degree       int
+		want         []float64
+		residualNorm float64
+		wantErr      error
+	}{
+		// Линейная регрессия
+		{[]float64{0, 1, 2}, []float64{6, 0, 0}, 1, []float64{5, -3}, math.Sqrt(6), nil},
+		{[]float64{-1, 0, 1, 2}, []float64{1, 0, 1, 4}, 2, []float64{0, 0, 1}, 0, nil},
+		{[]float64{1, 2, 3, 4}, []float64{2, 2, 2, 2}, 0, []float64{2}, 0, nil},
+		// Интерполяционный многочлен проходит через все точки
+		{[]float64{0, 1, 2}, []float64{1, 3, 2}, 2, []float64{1, 3.5, -1.5}, 0, nil},
+		{[]float64{0, 1}, []float64{1, 2}, -1, nil, 0, InvalidDegreeError(-1)},
+		{[]float64{0, 1}, []float64{1}, 1, nil, 0, NotSameSizeError(2, 1, 1, 1)},
+		{[]float64{1, 1, 1}, []float64{1, 2, 3}, 1, nil, 0, LinearlyDependentError(2)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v %v degree=%d", tt.x, tt.y, tt.degree)
+		t.Run(testname, func(t *testing.T) {
+			fit, err := FitPolynomial(tt.x, tt.y, tt.degree)
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while fitting: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !approximatelyEqual([][]float64{fit.Coefficients}, [][]float64{tt.want}, 1e-9) {
+				t.Errorf("got %v, want %v", fit.Coefficients, tt.want)
+			}
+			if math.Abs(fit.ResidualNorm-tt.residualNorm) > 1e-9 {
+				t.Errorf("got residual norm %g, want %g", fit.ResidualNorm, tt.residualNorm)
+			}
+		})
+	}
+}
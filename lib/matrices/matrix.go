@@ -1,35 +1,133 @@
 // Пакет matrices предоставляет реализацию алгоритмов матриц:
 //   - Создание матрицы
+//   - Подматрицы без копирования элементов (диапазоны и наборы строк и
+//     столбцов), составление матриц из блоков, извлечение строк и столбцов
 //   - Умножение и деление на число
 //   - Транспонирование матрицы
 //   - Сложение и вычитание матриц
-//   - Нахождение определителей 1-3 порядков
-//   - Умножение матриц
+//   - Нахождение определителей любого порядка (LU-разложение, разложение
+//     по строке)
+//   - Кронекерово произведение, произведение и деление Адамара, внешнее
+//     произведение векторов, векторизация матрицы
+//   - Умножение матриц (блочное, с распределением блоков строк между
+//     горутинами, алгоритмы Штрассена и Винограда)
+//   - LU-разложение с перестановкой строк (PLU), LDLᵀ-разложение и
+//     разложение Холецкого
+//   - Проверка симметричности, ортогональности и положительной
+//     определённости (разложение Холецкого, критерий Сильвестра)
+//   - QR-разложение (отражения Хаусхолдера, классический и модифицированный
+//     процесс Грама-Шмидта) и ортогонализация системы векторов
+//   - Сингулярное разложение (односторонний метод Якоби), псевдообратная
+//     матрица, численный ранг, спектральная норма и число обусловленности
+//   - Миноры, алгебраические дополнения, присоединённая матрица и разложение
+//     определителя по любой строке или столбцу
+//   - Нахождение обратной матрицы
+//   - Приведение к ступенчатому виду с записью элементарных преобразований
+//   - Ранг матрицы, базисы нуль-пространства, пространства столбцов и
+//     пространства строк
+//   - Решение систем линейных уравнений (метод Гаусса, метод Крамера,
+//     матричный метод)
+//   - Нормы матрицы (Фробениуса, 1-норма, ∞-норма, спектральная) и оценка
+//     числа обусловленности, предупреждения о неточности решения систем
+//   - Метод наименьших квадратов (нормальные уравнения, QR-разложение) и
+//     приближение точек многочленом
+//   - Собственные значения и собственные векторы (приведение к форме
+//     Хессенберга, QR-алгоритм со сдвигами, обратные итерации, метод Якоби
+//     для симметричных матриц)
+//   - Характеристический многочлен (алгоритм Берковица) и значение
+//     многочлена от матрицы
+//   - Возведение в целую степень (быстрое возведение в степень) и матричная
+//     экспонента (масштабирование и возведение в квадрат, аппроксимация Паде)
+//   - Разреженные матрицы (форматы COO и CSR) и итерационные методы решения
+//     систем (метод Якоби, метод Гаусса-Зейделя, метод сопряжённых
+//     градиентов)
+//   - Пошаговые объяснения умножения матриц и вычисления определителя
+//   - Матрицы рациональных чисел с точной арифметикой
+//   - Матрицы над произвольным полем (действительные, комплексные,
+//     рациональные числа, вычеты по простому модулю)
+//   - Матрицы вычетов: определитель, обратная матрица, ранг и решение систем
+//     по модулю
+//
+// Функции и методы, создающие матрицы или выполняющие над ними действия,
+// сообщают о неправильных аргументах ошибкой. Методы доступа к элементам
+// (At, Set, Row, Column) при выходе индекса за пределы матрицы вызывают
+// панику, как обращение к элементу среза.
 package matrices
 
-// Матрица действительных чисел
+// Точность, с которой численные алгоритмы сравнивают числа с нулём.
+var Epsilon = 1e-10
+
+// Число обусловленности, начиная с которого решатели предупреждают о
+// неточности результата: при таком числе обусловленности ошибки округления
+// могут исказить больше половины значащих цифр.
+var ConditionWarning = 1e8
+
+// Матрица действительных чисел. Элементы хранятся в одном срезе по строкам:
+// элемент в строке i и столбце j находится в data[i*stride+j]. Строки
+// расположены в памяти подряд, что ускоряет обход матрицы по строкам.
 type Matrix struct {
-	rows     int         // Количество строк
-	columns  int         // Количество столбцов
-	elements [][]float64 // Элементы матрицы
+	rows    int       // Количество строк
+	columns int       // Количество столбцов
+	stride  int       // Расстояние между началами соседних строк в data
+	data    []float64 // Элементы матрицы, записанные по строкам
 }
 
 // Возвращает нулевую матрицу.
 func ZeroMatrix(rows int, columns int) Matrix {
-	elements := make([][]float64, rows)
-	for i := range elements {
-		elements[i] = make([]float64, columns)
+	return Matrix{rows, columns, columns, make([]float64, rows*columns)}
+}
+
+// Возвращает матрицу, элементы которой скопированы из срезов строк.
+func matrixFromRows(rows int, columns int, elements [][]float64) Matrix {
+	result := ZeroMatrix(rows, columns)
+	for i := 0; i < rows; i++ {
+		copy(result.row(i), elements[i])
 	}
-	return Matrix{rows, columns, elements}
+	return result
+}
+
+// Возвращает срез i-й строки матрицы. Срез ссылается на элементы матрицы,
+// поэтому изменение среза изменяет матрицу.
+func (m Matrix) row(i int) []float64 {
+	start := i * m.stride
+	return m.data[start : start+m.columns : start+m.columns]
 }
 
-// Возвращает матрицу действительных чисел.
+// Возвращает срезы всех строк матрицы для алгоритмов, работающих с
+// элементами вида [][]T. Срезы ссылаются на элементы матрицы.
+func (m Matrix) rowSlices() [][]float64 {
+	rows := make([][]float64, m.rows)
+	for i := range rows {
+		rows[i] = m.row(i)
+	}
+	return rows
+}
+
+// Меняет местами строки матрицы.
+func (m *Matrix) swapRows(a int, b int) {
+	rowA, rowB := m.row(a), m.row(b)
+	for j := range rowA {
+		rowA[j], rowB[j] = rowB[j], rowA[j]
+	}
+}
+
+// Возвращает единичную матрицу порядка n.
+func IdentityMatrix(n int) Matrix {
+	identity := ZeroMatrix(n, n)
+	for i := 0; i < n; i++ {
+		identity.row(i)[i] = 1
+	}
+	return identity
+}
+
+// Возвращает матрицу действительных чисел. Элементы копируются, поэтому
+// дальнейшее изменение переданного массива не изменяет матрицу.
 //
 // Возвращает ошибку, если в матрице не одинаковое количество столбцов.
 func NewMatrix(elements [][]float64) (Matrix, error) {
 	// Проверка правильности заданной матрицы
 	rows := len(elements)
-	columns := -1
+	columns := 0
 	for i := 0; i < rows; i++ {
 		if i == 0 {
 			columns = len(elements[i])
@@ -37,14 +135,36 @@ func NewMatrix(elements [][]float64) (Matrix, error) {
 			return Matrix{}, InvalidMatrixError(i + 1)
 		}
 	}
-	return Matrix{rows, columns, elements}, nil
+	return matrixFromRows(rows, columns, elements), nil
+}
+
+// Возвращает количество строк матрицы.
+func (m Matrix) Rows() int {
+	return m.rows
+}
+
+// Возвращает количество столбцов матрицы.
+func (m Matrix) Columns() int {
+	return m.columns
+}
+
+// Возвращает элемент матрицы в заданной строке и столбце (нумерация с нуля).
+// Если индекс выходит за пределы матрицы, вызывается паника.
+func (m Matrix) At(row int, column int) float64 {
+	return m.row(row)[column]
+}
+
+// Возвращает копию элементов матрицы.
+func (m Matrix) Elements() [][]float64 {
+	return m.clone().rowSlices()
 }
 
 // Умножает каждый элемент матрицы на заданное число.
 func (m *Matrix) MultiplyByNumber(number float64) {
 	for i := 0; i < m.rows; i++ {
-		for j := 0; j < m.columns; j++ {
-			m.elements[i][j] *= number
+		row := m.row(i)
+		for j := range row {
+			row[j] *= number
 		}
 	}
 }
@@ -52,8 +172,9 @@ func (m *Matrix) MultiplyByNumber(number float64) {
 // Делит каждый элемент матрицы на заданное число.
 func (m *Matrix) DivideByNumber(number float64) {
 	for i := 0; i < m.rows; i++ {
-		for j := 0; j < m.columns; j++ {
-			m.elements[i][j] /= number
+		row := m.row(i)
+		for j := range row {
+			row[j] /= number
 		}
 	}
 }
@@ -61,13 +182,7 @@ func (m *Matrix) DivideByNumber(number float64) {
 // Возвращает транспонированную матрицу, то есть матрицу, в которой строки
 // записаны как столбцы, а столбцы - как строки.
 func (m Matrix) Transpose() Matrix {
-	transposed := ZeroMatrix(m.columns, m.rows)
-	for i := 0; i < m.rows; i++ {
-		for j := 0; j < m.columns; j++ {
-			transposed.elements[j][i] = m.elements[i][j]
-		}
-	}
-	return transposed
+	return matrixFromRows(m.columns, m.rows, transposeElements(m.rowSlices(), m.rows, m.columns))
 }
 
 // Прибавляет к каждому элементу матрицы элементы другой матрицы.
@@ -79,44 +194,28 @@ func (m *Matrix) AddMatrix(other Matrix, negative bool) error {
 		return NotSameSizeError(m.rows, m.columns, other.rows, other.columns)
 	}
 
-	for i := 0; i < m.rows; i++ {
-		for j := 0; j < m.columns; j++ {
-			if negative {
-				m.elements[i][j] -= other.elements[i][j]
-			} else {
-				m.elements[i][j] += other.elements[i][j]
-			}
-		}
-	}
+	// Срезы строк ссылаются на элементы матрицы, поэтому сложение выполняется
+	// на месте
+	addElements(RealField{}, m.rowSlices(), other.rowSlices(), negative)
 
 	return nil
 }
 
 // Возвращает матрицу, являющуюся результатом умножения одной текущей матрицы
-// на другую заданную.
+// на другую заданную. Используется блочное умножение с параметрами по
+// умолчанию (см. MultiplyMatrixWith). В отличие от остальных операций,
+// умножение не использует общий для всех полей код: блочный параллельный
+// алгоритм написан для чисел с плавающей точкой, а матрицы над другими
+// полями перемножаются по определению.
 //
 // Возвращает ошибку, если матрицы нельзя перемножить (количество столбцов
 // первой матрицы не количеству строк второй)
 func (m Matrix) MultiplyMatrix(other Matrix) (Matrix, error) {
-	// Число столбцов первой матрицы должно совпадать с числом строк второй
-	if m.columns != other.rows {
-		return Matrix{}, UnableToMultiplyError(m.columns, other.rows)
-	}
-
-	result := ZeroMatrix(m.rows, other.columns)
-
-	for i := 0; i < result.rows; i++ {
-		for j := 0; j < result.columns; j++ {
-			for k := 0; k < m.columns; k++ {
-				result.elements[i][j] += m.elements[i][k] * other.elements[k][j]
-			}
-		}
-	}
-
-	return result, nil
+	return m.MultiplyMatrixWith(other, MultiplyOptions{})
 }
 
-// Возвращает определитель квадратной матрицы.
+// Возвращает определитель квадратной матрицы. Для матриц 1-3 порядков
+// используются явные формулы, для остальных - LU-разложение.
 //
 // Возвращает ошибку, если матрица не квадратная.
 func (m *Matrix) Determinator() (float64, error) {
@@ -128,12 +227,17 @@ func (m *Matrix) Determinator() (float64, error) {
 	// Вычисляем определитель
 	determinator := 0.0
 	switch m.columns {
+	case 0:
+		// Определитель пустой матрицы по соглашению равен единице
+		determinator = 1
+	case 1:
+		determinator = m.row(0)[0]
 	case 2:
-		determinator = m.elements[0][0]*m.elements[1][1] - m.elements[0][1]*m.elements[1][0]
+		determinator = m.row(0)[0]*m.row(1)[1] - m.row(0)[1]*m.row(1)[0]
 	case 3:
-		determinator = m.elements[0][0]*m.elements[1][1]*m.elements[2][2] + m.elements[2][0]*m.elements[0][1]*m.elements[1][2] + m.elements[0][2]*m.elements[1][0]*m.elements[2][1] - m.elements[0][2]*m.elements[1][1]*m.elements[2][0] - m.elements[0][0]*m.elements[1][2]*m.elements[2][1] - m.elements[2][2]*m.elements[0][1]*m.elements[1][0]
+		determinator = m.row(0)[0]*m.row(1)[1]*m.row(2)[2] + m.row(2)[0]*m.row(0)[1]*m.row(1)[2] + m.row(0)[2]*m.row(1)[0]*m.row(2)[1] - m.row(0)[2]*m.row(1)[1]*m.row(2)[0] - m.row(0)[0]*m.row(1)[2]*m.row(2)[1] - m.row(2)[2]*m.row(0)[1]*m.row(1)[0]
 	default:
-		determinator = m.elements[0][0]
+		determinator = m.determinatorLU()
 	}
 
 	return determinator, nil
@@ -56,7 +56,7 @@ func TestMatrixNumbersOperations(t *testing.T) {
 			case 1:
 				matrix.DivideByNumber(tt.number)
 			}
-			got := matrix.elements
+			got := matrix.rowSlices()
 			if fmt.Sprintf("%v", got) != fmt.Sprintf("%v", tt.want) {
 				t.Errorf("got %v, want %v", got, tt.want)
 			}
@@ -81,7 +81,7 @@ func TestMatrixTranspose(t *testing.T) {
 			if err != nil {
 				t.Fatalf("got an error while initializing Matrix: %v", err)
 			}
-			got := matrix.Transpose().elements
+			got := matrix.Transpose().rowSlices()
 			if fmt.Sprintf("%v", got) != fmt.Sprintf("%v", tt.want) {
 				t.Errorf("got %v, want %v", got, tt.want)
 			}
@@ -125,8 +125,8 @@ func TestMatrixAddAndSubstract(t *testing.T) {
 			if got != nil && got.Error() != tt.wantErr.Error() {
 				t.Fatalf("got %v, want %v", got, tt.want)
 			}
-			if fmt.Sprintf("%v", matrix1.elements) != fmt.Sprintf("%v", tt.want) {
-				t.Errorf("got %v, want %v", matrix1.elements, tt.want)
+			if fmt.Sprintf("%v", matrix1.rowSlices()) != fmt.Sprintf("%v", tt.want) {
+				t.Errorf("got %v, want %v", matrix1.rowSlices(), tt.want)
 			}
 		})
 	}
@@ -166,8 +166,8 @@ func TestMatrixMultiply(t *testing.T) {
 			if err != nil && err.Error() != tt.wantErr.Error() {
 				t.Fatalf("got %v, want %v", err, tt.want)
 			}
-			if err == nil && fmt.Sprintf("%v", got.elements) != fmt.Sprintf("%v", tt.want) {
-				t.Errorf("got %v, want %v", got.elements, tt.want)
+			if err == nil && fmt.Sprintf("%v", got.rowSlices()) != fmt.Sprintf("%v", tt.want) {
+				t.Errorf("got %v, want %v", got.rowSlices(), tt.want)
 			}
 		})
 	}
@@ -183,6 +183,7 @@ func TestMatrixDeterminator(t *testing.T) {
 		// Ошибка
 		{[][]float64{{1, 2, 3}, {4, 5, 6}}, 0, NotSquareMatrixError()},
 		// Нет ошибок
+		{[][]float64{}, 1, nil},
 		{[][]float64{{5}}, 5, nil},
 		{[][]float64{{11, -3}, {-15, -2}}, -67, nil},
 		{[][]float64{{1, -2, 3}, {4, 0, 6}, {-7, 8, 9}}, 204, nil},
@@ -209,3 +210,41 @@ func TestMatrixDeterminator(t *testing.T) {
 		})
 	}
 }
+
+// Доступ к размерам и элементам матрицы
+func TestMatrixAccessors(t *testing.T) {
+	elements := [][]float64{{1, 2, 3}, {4, 5, 6}}
+	matrix, err := NewMatrix(elements)
+	if err != nil {
+		t.Fatalf("got an error while initializing Matrix: %v", err)
+	}
+	if matrix.Rows() != 2 || matrix.Columns() != 3 {
+		t.Errorf("got size %dx%d, want 2x3", matrix.Rows(), matrix.Columns())
+	}
+	if got := matrix.At(1, 2); got != 6 {
+		t.Errorf("got %f, want 6", got)
+	}
+
+	// Изменение копии не должно менять матрицу
+	copied := matrix.Elements()
+	copied[0][0] = 100
+	if got := matrix.At(0, 0); got != 1 {
+		t.Errorf("got %f, want 1", got)
+	}
+
+	// Индекс за пределами строки не должен попадать в следующую строку
+	for _, index := range [][2]int{{0, 3}, {1, 3}, {2, 0}, {0, -1}} {
+		if !panics(func() { matrix.At(index[0], index[1]) }) {
+			t.Errorf("got no panic for At(%d, %d)", index[0], index[1])
+		}
+	}
+}
+
+// Возвращает true, если функция вызывает панику.
+func panics(f func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	f()
+	return false
+}
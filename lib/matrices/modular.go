@@ -0,0 +1,262 @@
+package matrices
+
+import "math/big"
+
+// Матрица вычетов по модулю. Сложение и умножение работают для любого модуля,
+// а ранг и решение систем - только для простого модуля, когда вычеты
+// образуют поле GF(p).
+type ModularMatrix struct {
+	field    ModularField // Кольцо вычетов
+	rows     int          // Количество строк
+	columns  int          // Количество столбцов
+	elements [][]int64    // Элементы матрицы (числа от 0 до модуля-1)
+}
+
+// Решение системы линейных уравнений по модулю.
+//
+// Общее решение системы записывается как x = Particular + Σ cᵢ * Basis[i],
+// где cᵢ - произвольные вычеты.
+type ModularSolution struct {
+	Kind       SolutionKind // Количество решений (для конечного поля "бесконечно много" означает p^len(Basis))
+	Particular []int64      // Частное решение (пустое, если система несовместна)
+	Basis      [][]int64    // Фундаментальная система решений однородной системы
+}
+
+// Возвращает нулевую матрицу вычетов.
+//
+// Возвращает ошибку, если модуль меньше двух.
+func ZeroModularMatrix(rows int, columns int, modulus int64) (ModularMatrix, error) {
+	if modulus < 2 {
+		return ModularMatrix{}, InvalidModulusError(modulus)
+	}
+	field := ModularField{modulus}
+	return ModularMatrix{field, rows, columns, zeroElements(field, rows, columns)}, nil
+}
+
+// Возвращает единичную матрицу вычетов порядка n.
+//
+// Возвращает ошибку, если модуль меньше двух.
+func IdentityModularMatrix(n int, modulus int64) (ModularMatrix, error) {
+	identity, err := ZeroModularMatrix(n, n, modulus)
+	if err != nil {
+		return ModularMatrix{}, err
+	}
+	for i := 0; i < n; i++ {
+		identity.elements[i][i] = 1
+	}
+	return identity, nil
+}
+
+// Возвращает матрицу вычетов по заданному модулю. Элементы приводятся к
+// числам от 0 до модуля-1, исходный массив не изменяется.
+//
+// Возвращает ошибку, если модуль меньше двух или в матрице не одинаковое
+// количество столбцов.
+func NewModularMatrix(elements [][]int64, modulus int64) (ModularMatrix, error) {
+	if modulus < 2 {
+		return ModularMatrix{}, InvalidModulusError(modulus)
+	}
+	field := ModularField{modulus}
+
+	// Проверка правильности заданной матрицы
+	rows := len(elements)
+	columns := 0
+	reduced := make([][]int64, rows)
+	for i := 0; i < rows; i++ {
+		if i == 0 {
+			columns = len(elements[i])
+		} else if len(elements[i]) != columns {
+			return ModularMatrix{}, InvalidMatrixError(i + 1)
+		}
+		reduced[i] = make([]int64, columns)
+		for j := range elements[i] {
+			reduced[i][j] = field.Reduce(elements[i][j])
+		}
+	}
+	return ModularMatrix{field, rows, columns, reduced}, nil
+}
+
+// Возвращает модуль матрицы.
+func (m ModularMatrix) Modulus() int64 {
+	return m.field.Modulus
+}
+
+// Возвращает количество строк матрицы.
+func (m ModularMatrix) Rows() int {
+	return m.rows
+}
+
+// Возвращает количество столбцов матрицы.
+func (m ModularMatrix) Columns() int {
+	return m.columns
+}
+
+// Возвращает элемент матрицы в заданной строке и столбце (нумерация с нуля).
+func (m ModularMatrix) At(row int, column int) int64 {
+	return m.elements[row][column]
+}
+
+// Возвращает копию элементов матрицы.
+func (m ModularMatrix) Elements() [][]int64 {
+	return cloneElements(m.elements)
+}
+
+// Возвращает true, если модуль матрицы - простое число.
+func (m ModularMatrix) IsPrimeModulus() bool {
+	return big.NewInt(m.field.Modulus).ProbablyPrime(0)
+}
+
+// Умножает каждый элемент матрицы на заданное число.
+func (m *ModularMatrix) MultiplyByNumber(number int64) {
+	scaleElements(m.field, m.elements, number)
+}
+
+// Возвращает транспонированную матрицу.
+func (m ModularMatrix) Transpose() ModularMatrix {
+	return ModularMatrix{m.field, m.columns, m.rows, transposeElements(m.elements, m.rows, m.columns)}
+}
+
+// Прибавляет к каждому элементу матрицы элементы другой матрицы.
+//
+// Если аргумент negative равен true, то будет произведено вычитание матриц.
+//
+// Возвращает ошибку, если у матриц разные размеры или модули.
+func (m *ModularMatrix) AddMatrix(other ModularMatrix, negative bool) error {
+	if m.field != other.field {
+		return DifferentModulusError(m.field.Modulus, other.field.Modulus)
+	}
+	// У матриц должны быть равно количество строк и столбцов
+	if m.rows != other.rows || m.columns != other.columns {
+		return NotSameSizeError(m.rows, m.columns, other.rows, other.columns)
+	}
+
+	addElements(m.field, m.elements, other.elements, negative)
+
+	return nil
+}
+
+// Возвращает матрицу, являющуюся результатом умножения текущей матрицы на
+// другую заданную.
+//
+// Возвращает ошибку, если у матриц разные модули или их нельзя перемножить.
+func (m ModularMatrix) MultiplyMatrix(other ModularMatrix) (ModularMatrix, error) {
+	if m.field != other.field {
+		return ModularMatrix{}, DifferentModulusError(m.field.Modulus, other.field.Modulus)
+	}
+	// Число столбцов первой матрицы должно совпадать с числом строк второй
+	if m.columns != other.rows {
+		return ModularMatrix{}, UnableToMultiplyError(m.columns, other.rows)
+	}
+
+	elements := multiplyElements(m.field, m.elements, other.elements, m.rows, m.columns, other.columns)
+	return ModularMatrix{m.field, m.rows, other.columns, elements}, nil
+}
+
+// Возвращает определитель квадратной матрицы по модулю. Для простого модуля
+// определитель вычисляется методом Гаусса в поле GF(p), для составного -
+// точно в рациональных числах с последующим приведением по модулю.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m ModularMatrix) Determinator() (int64, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return 0, NotSquareMatrixError()
+	}
+
+	if m.IsPrimeModulus() {
+		return determinatorElements(m.field, m.elements, m.rows), nil
+	}
+
+	determinator := determinatorElements(RationalField{}, m.toRationals(), m.rows)
+	return m.reduceInteger(determinator.Num()), nil
+}
+
+// Возвращает обратную по модулю матрицу. Для простого модуля используется
+// метод Гаусса-Жордана в поле GF(p). Для составного модуля используется
+// формула A⁻¹ = (det A)⁻¹ * adj(A), где присоединённая матрица вычисляется
+// точно в рациональных числах.
+//
+// Возвращает ошибку, если матрица не квадратная или её определитель не
+// взаимно прост с модулем.
+func (m ModularMatrix) Inverse() (ModularMatrix, error) {
+	determinator, err := m.Determinator()
+	if err != nil {
+		return ModularMatrix{}, err
+	}
+	determinatorInverse, ok := m.field.Inverse(determinator)
+	if !ok {
+		return ModularMatrix{}, NotInvertibleModularMatrixError(determinator, m.field.Modulus)
+	}
+
+	if m.IsPrimeModulus() {
+		elements, err := inverseElements(m.field, m.elements, m.rows)
+		if err != nil {
+			return ModularMatrix{}, err
+		}
+		return ModularMatrix{m.field, m.rows, m.columns, elements}, nil
+	}
+
+	// adj(A) = det A * A⁻¹ состоит из целых чисел
+	rationals := m.toRationals()
+	exactDeterminator := determinatorElements(RationalField{}, rationals, m.rows)
+	exactInverse, err := inverseElements(RationalField{}, rationals, m.rows)
+	if err != nil {
+		return ModularMatrix{}, err
+	}
+	inverse := zeroElements(m.field, m.rows, m.columns)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.columns; j++ {
+			adjugate := new(big.Rat).Mul(exactInverse[i][j], exactDeterminator)
+			inverse[i][j] = m.field.Mul(m.reduceInteger(adjugate.Num()), determinatorInverse)
+		}
+	}
+	return ModularMatrix{m.field, m.rows, m.columns, inverse}, nil
+}
+
+// Возвращает ранг матрицы над полем GF(p).
+//
+// Возвращает ошибку, если модуль не простой.
+func (m ModularMatrix) Rank() (int, error) {
+	if !m.IsPrimeModulus() {
+		return 0, NotPrimeModulusError(m.field.Modulus)
+	}
+	pivots, _ := rowReduceElements(m.field, cloneElements(m.elements), m.columns, false)
+	return len(pivots), nil
+}
+
+// Решает систему линейных уравнений Ax = b над полем GF(p) методом Гаусса.
+//
+// Возвращает ошибку, если модуль не простой или длина b не равна количеству
+// строк A.
+func (m ModularMatrix) Solve(b []int64) (ModularSolution, error) {
+	if !m.IsPrimeModulus() {
+		return ModularSolution{}, NotPrimeModulusError(m.field.Modulus)
+	}
+	if len(b) != m.rows {
+		return ModularSolution{}, NotSameSizeError(m.rows, 1, len(b), 1)
+	}
+
+	reduced := make([]int64, len(b))
+	for i := range b {
+		reduced[i] = m.field.Reduce(b[i])
+	}
+	kind, particular, basis := solveElements(m.field, m.elements, reduced, m.columns)
+	return ModularSolution{kind, particular, basis}, nil
+}
+
+// Возвращает элементы матрицы в виде рациональных чисел.
+func (m ModularMatrix) toRationals() [][]*big.Rat {
+	rationals := make([][]*big.Rat, m.rows)
+	for i := range rationals {
+		rationals[i] = make([]*big.Rat, m.columns)
+		for j := range rationals[i] {
+			rationals[i][j] = big.NewRat(m.elements[i][j], 1)
+		}
+	}
+	return rationals
+}
+
+// Приводит целое число произвольной длины по модулю матрицы.
+func (m ModularMatrix) reduceInteger(number *big.Int) int64 {
+	return new(big.Int).Mod(number, big.NewInt(m.field.Modulus)).Int64()
+}
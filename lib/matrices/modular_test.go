@@ -0,0 +1,179 @@
+package matrices
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Создание матрицы вычетов
+func TestNewModularMatrix(t *testing.T) {
+	tests := []struct {
+		elements [][]int64
+		modulus  int64
+		want     [][]int64
+		wantErr  error
+	}{
+		{[][]int64{{1, 2}, {3, 4}}, 1, nil, InvalidModulusError(1)},
+		{[][]int64{{1, 2}, {3}}, 7, nil, InvalidMatrixError(2)},
+		{[][]int64{{-1, 9}, {26, 27}}, 26, [][]int64{{25, 9}, {0, 1}}, nil},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v mod %d", tt.elements, tt.modulus)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewModularMatrix(tt.elements, tt.modulus)
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while initializing ModularMatrix: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err == nil && fmt.Sprintf("%v", matrix.Elements()) != fmt.Sprintf("%v", tt.want) {
+				t.Errorf("got %v, want %v", matrix.Elements(), tt.want)
+			}
+		})
+	}
+}
+
+// Сложение и умножение по модулю
+func TestModularMatrixOperations(t *testing.T) {
+	matrix1, _ := NewModularMatrix([][]int64{{3, 3}, {2, 5}}, 26)
+	matrix2, _ := NewModularMatrix([][]int64{{15, 17}, {20, 9}}, 26)
+
+	product, err := matrix1.MultiplyMatrix(matrix2)
+	if err != nil {
+		t.Fatalf("got an error while multiplying: %v", err)
+	}
+	if want := "[[1 0] [0 1]]"; fmt.Sprintf("%v", product.elements) != want {
+		t.Errorf("got %v, want %v", product.elements, want)
+	}
+
+	if err := matrix1.AddMatrix(matrix2, true); err != nil {
+		t.Fatalf("got an error while subtracting: %v", err)
+	}
+	if want := "[[14 12] [8 22]]"; fmt.Sprintf("%v", matrix1.elements) != want {
+		t.Errorf("got %v, want %v", matrix1.elements, want)
+	}
+
+	matrix1.MultiplyByNumber(-1)
+	if want := "[[12 18] [14 4]]"; fmt.Sprintf("%v", matrix1.Transpose().elements) != want {
+		t.Errorf("got %v, want %v", matrix1.Transpose().elements, want)
+	}
+
+	other, _ := NewModularMatrix([][]int64{{1, 0}, {0, 1}}, 7)
+	if _, err := matrix1.MultiplyMatrix(other); err == nil || err.Error() != DifferentModulusError(26, 7).Error() {
+		t.Errorf("got %v, want %v", err, DifferentModulusError(26, 7))
+	}
+	if err := matrix1.AddMatrix(other, false); err == nil || err.Error() != DifferentModulusError(26, 7).Error() {
+		t.Errorf("got %v, want %v", err, DifferentModulusError(26, 7))
+	}
+}
+
+// Определитель и обратная матрица по простому и составному модулю
+func TestModularMatrixInverse(t *testing.T) {
+	tests := []struct {
+		elements        [][]int64
+		modulus         int64
+		wantDeterminant int64
+		want            [][]int64
+		wantErr         error
+	}{
+		{[][]int64{{3, 3}, {2, 5}}, 26, 9, [][]int64{{15, 17}, {20, 9}}, nil},
+		{[][]int64{{6, 24, 1}, {13, 16, 10}, {20, 17, 15}}, 26, 25, [][]int64{{8, 5, 10}, {21, 8, 21}, {21, 12, 8}}, nil},
+		{[][]int64{{1, 2}, {3, 4}}, 7, 5, [][]int64{{5, 1}, {5, 3}}, nil},
+		{[][]int64{{2, 0}, {0, 1}}, 26, 2, nil, NotInvertibleModularMatrixError(2, 26)},
+		{[][]int64{{1, 2}, {3, 6}}, 7, 0, nil, NotInvertibleModularMatrixError(0, 7)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v mod %d", tt.elements, tt.modulus)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewModularMatrix(tt.elements, tt.modulus)
+			if err != nil {
+				t.Fatalf("got an error while initializing ModularMatrix: %v", err)
+			}
+			determinator, err := matrix.Determinator()
+			if err != nil {
+				t.Fatalf("got an error while calculating Determinator: %v", err)
+			}
+			if determinator != tt.wantDeterminant {
+				t.Errorf("got determinator %d, want %d", determinator, tt.wantDeterminant)
+			}
+
+			inverse, err := matrix.Inverse()
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while inverting: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err == nil && fmt.Sprintf("%v", inverse.elements) != fmt.Sprintf("%v", tt.want) {
+				t.Errorf("got %v, want %v", inverse.elements, tt.want)
+			}
+		})
+	}
+}
+
+// Ранг и решение систем над полем GF(p)
+func TestModularMatrixSolve(t *testing.T) {
+	tests := []struct {
+		elements       [][]int64
+		b              []int64
+		modulus        int64
+		wantRank       int
+		wantKind       SolutionKind
+		wantParticular []int64
+		wantBasis      [][]int64
+	}{
+		{[][]int64{{1, 2}, {3, 4}}, []int64{5, 6}, 7, 2, UniqueSolution, []int64{3, 1}, [][]int64{}},
+		{[][]int64{{1, 2, 3}, {2, 4, 6}}, []int64{1, 2}, 5, 1, InfiniteSolutions, []int64{1, 0, 0}, [][]int64{{3, 1, 0}, {2, 0, 1}}},
+		{[][]int64{{1, 1}, {1, 1}}, []int64{0, 1}, 2, 1, NoSolution, []int64{}, [][]int64{}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v|%v mod %d", tt.elements, tt.b, tt.modulus)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewModularMatrix(tt.elements, tt.modulus)
+			if err != nil {
+				t.Fatalf("got an error while initializing ModularMatrix: %v", err)
+			}
+			rank, err := matrix.Rank()
+			if err != nil {
+				t.Fatalf("got an error while calculating rank: %v", err)
+			}
+			if rank != tt.wantRank {
+				t.Errorf("got rank %d, want %d", rank, tt.wantRank)
+			}
+
+			got, err := matrix.Solve(tt.b)
+			if err != nil {
+				t.Fatalf("got an error while solving: %v", err)
+			}
+			if got.Kind != tt.wantKind {
+				t.Errorf("got kind %d, want %d", got.Kind, tt.wantKind)
+			}
+			if fmt.Sprintf("%v", got.Particular) != fmt.Sprintf("%v", tt.wantParticular) {
+				t.Errorf("got particular %v, want %v", got.Particular, tt.wantParticular)
+			}
+			if fmt.Sprintf("%v", got.Basis) != fmt.Sprintf("%v", tt.wantBasis) {
+				t.Errorf("got basis %v, want %v", got.Basis, tt.wantBasis)
+			}
+		})
+	}
+}
+
+// Ранг и решение систем требуют простой модуль
+func TestModularMatrixNotPrime(t *testing.T) {
+	matrix, _ := NewModularMatrix([][]int64{{1, 2}, {3, 4}}, 26)
+	if _, err := matrix.Rank(); err == nil || err.Error() != NotPrimeModulusError(26).Error() {
+		t.Errorf("got %v, want %v", err, NotPrimeModulusError(26))
+	}
+	if _, err := matrix.Solve([]int64{1, 2}); err == nil || err.Error() != NotPrimeModulusError(26).Error() {
+		t.Errorf("got %v, want %v", err, NotPrimeModulusError(26))
+	}
+
+	prime, _ := NewModularMatrix([][]int64{{1, 2}, {3, 4}}, 7)
+	if _, err := prime.Solve([]int64{1}); err == nil || err.Error() != NotSameSizeError(2, 1, 1, 1).Error() {
+		t.Errorf("got %v, want %v", err, NotSameSizeError(2, 1, 1, 1))
+	}
+}
@@ -0,0 +1,109 @@
+package matrices
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Размер блока блочного умножения матриц по умолчанию. Три блока 64×64 из
+// float64 занимают 96 КиБ и помещаются в кэш второго уровня.
+const DefaultBlockSize = 64
+
+// Количество умножений чисел, начиная с которого умножение матриц
+// распределяется между несколькими горутинами. Для меньших матриц запуск
+// горутин обходится дороже самого умножения.
+const parallelMultiplyThreshold = 1 << 18
+
+// Параметры умножения матриц.
+type MultiplyOptions struct {
+	// Количество горутин, между которыми распределяются блоки строк. Если
+	// равно нулю, используется runtime.GOMAXPROCS(0). Если равно единице,
+	// умножение выполняется в текущей горутине.
+	Workers int
+	// Размер квадратного блока. Если равен нулю, используется
+	// DefaultBlockSize.
+	BlockSize int
+}
+
+func (o MultiplyOptions) workers() int {
+	if o.Workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return o.Workers
+}
+
+func (o MultiplyOptions) blockSize() int {
+	if o.BlockSize <= 0 {
+		return DefaultBlockSize
+	}
+	return o.BlockSize
+}
+
+// Возвращает матрицу, являющуюся результатом умножения текущей матрицы на
+// другую заданную, с заданными параметрами.
+//
+// Матрицы разбиваются на квадратные блоки, которые умножаются так, чтобы
+// используемые строки обеих матриц оставались в кэше процессора. Блоки строк
+// результата независимы и распределяются между горутинами.
+//
+// Возвращает ошибку, если матрицы нельзя перемножить.
+func (m Matrix) MultiplyMatrixWith(other Matrix, options MultiplyOptions) (Matrix, error) {
+	// Число столбцов первой матрицы должно совпадать с числом строк второй
+	if m.columns != other.rows {
+		return Matrix{}, UnableToMultiplyError(m.columns, other.rows)
+	}
+
+	result := ZeroMatrix(m.rows, other.columns)
+	blockSize := options.blockSize()
+	blocks := (m.rows + blockSize - 1) / blockSize
+	workers := min(options.workers(), blocks)
+	if workers <= 1 || m.rows*m.columns*other.columns < parallelMultiplyThreshold {
+		for block := 0; block < blocks; block++ {
+			multiplyBlock(m, other, result, block*blockSize, blockSize)
+		}
+		return result, nil
+	}
+
+	// Горутины по очереди берут следующий необработанный блок строк
+	var next atomic.Int64
+	var wait sync.WaitGroup
+	wait.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wait.Done()
+			for {
+				block := int(next.Add(1)) - 1
+				if block >= blocks {
+					return
+				}
+				multiplyBlock(m, other, result, block*blockSize, blockSize)
+			}
+		}()
+	}
+	wait.Wait()
+	return result, nil
+}
+
+// Вычисляет строки результата с first по first+blockSize-1, прибавляя к ним
+// произведения блоков матриц a и b.
+func multiplyBlock(a Matrix, b Matrix, result Matrix, first int, blockSize int) {
+	last := min(first+blockSize, a.rows)
+	for kStart := 0; kStart < a.columns; kStart += blockSize {
+		kEnd := min(kStart+blockSize, a.columns)
+		for jStart := 0; jStart < b.columns; jStart += blockSize {
+			jEnd := min(jStart+blockSize, b.columns)
+			for i := first; i < last; i++ {
+				resultRow := result.row(i)[jStart:jEnd]
+				aRow := a.row(i)
+				for k := kStart; k < kEnd; k++ {
+					factor := aRow[k]
+					bRow := b.row(k)[jStart:jEnd]
+					for j := range bRow {
+						resultRow[j] += factor * bRow[j]
+					}
+				}
+			}
+		}
+	}
+}
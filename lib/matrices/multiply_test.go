@@ -0,0 +1,128 @@
+package matrices
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// Возвращает матрицу заданного размера со случайными элементами от -1 до 1.
+func randomMatrix(random *rand.Rand, rows int, columns int) Matrix {
+	result := ZeroMatrix(rows, columns)
+	for i := range result.data {
+		result.data[i] = 2*random.Float64() - 1
+	}
+	return result
+}
+
+// Блочное умножение совпадает с умножением по определению при любом
+// разбиении на блоки и количестве горутин
+func TestMultiplyMatrixWith(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	tests := []struct {
+		rows    int
+		inner   int
+		columns int
+		options MultiplyOptions
+	}{
+		{1, 1, 1, MultiplyOptions{}},
+		{3, 5, 2, MultiplyOptions{BlockSize: 2}},
+		{70, 65, 130, MultiplyOptions{Workers: 1}},
+		{70, 65, 130, MultiplyOptions{Workers: 3, BlockSize: 16}},
+		{129, 100, 67, MultiplyOptions{Workers: 8, BlockSize: 7}},
+		// Достаточно большие матрицы умножаются параллельно
+		{100, 100, 100, MultiplyOptions{Workers: 4, BlockSize: 32}},
+		{0, 3, 4, MultiplyOptions{}},
+		{2, 0, 2, MultiplyOptions{}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%dx%dx%d,%+v", tt.rows, tt.inner, tt.columns, tt.options)
+		t.Run(testname, func(t *testing.T) {
+			a := randomMatrix(random, tt.rows, tt.inner)
+			b := randomMatrix(random, tt.inner, tt.columns)
+			got, err := a.MultiplyMatrixWith(b, tt.options)
+			if err != nil {
+				t.Fatalf("got an error while calling MultiplyMatrixWith: %v", err)
+			}
+			want := multiplyElements(RealField{}, a.rowSlices(), b.rowSlices(), tt.rows, tt.inner, tt.columns)
+			if got.Rows() != tt.rows || got.Columns() != tt.columns {
+				t.Fatalf("got size %dx%d, want %dx%d", got.Rows(), got.Columns(), tt.rows, tt.columns)
+			}
+			if !approximatelyEqual(got.rowSlices(), want, 1e-12) {
+				t.Errorf("got %v, want %v", got.rowSlices(), want)
+			}
+		})
+	}
+
+	a := ZeroMatrix(2, 3)
+	if _, err := a.MultiplyMatrixWith(a, MultiplyOptions{}); err == nil || err.Error() != UnableToMultiplyError(3, 2).Error() {
+		t.Errorf("got %v, want %v", err, UnableToMultiplyError(3, 2))
+	}
+}
+
+// Элементы хранятся подряд по строкам, а NewMatrix копирует переданный массив
+func TestMatrixStorage(t *testing.T) {
+	elements := [][]float64{{1, 2, 3}, {4, 5, 6}}
+	matrix, _ := NewMatrix(elements)
+	elements[0][0] = 100
+	if matrix.At(0, 0) != 1 {
+		t.Errorf("got %g, want 1", matrix.At(0, 0))
+	}
+	if fmt.Sprint(matrix.data) != "[1 2 3 4 5 6]" || matrix.stride != 3 {
+		t.Errorf("got data %v with stride %d, want [1 2 3 4 5 6] with stride 3", matrix.data, matrix.stride)
+	}
+
+	transposed := matrix.Transpose()
+	if fmt.Sprint(transposed.data) != "[1 4 2 5 3 6]" {
+		t.Errorf("got %v, want [1 4 2 5 3 6]", transposed.data)
+	}
+
+	// Перестановка строк меняет местами элементы, а не срезы строк
+	matrix.ApplyRowOperation(RowOperation{SwapRows, 0, 1, 0})
+	if fmt.Sprint(matrix.data) != "[4 5 6 1 2 3]" {
+		t.Errorf("got %v, want [4 5 6 1 2 3]", matrix.data)
+	}
+}
+
+// Сравнение умножения по определению с блочным умножением в одной и
+// нескольких горутинах
+func BenchmarkMultiplyMatrix(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	for _, n := range []int{256, 512, 1024, 2048} {
+		x := randomMatrix(random, n, n)
+		y := randomMatrix(random, n, n)
+
+		b.Run(fmt.Sprintf("naive/%d", n), func(b *testing.B) {
+			xRows, yRows := x.rowSlices(), y.rowSlices()
+			for i := 0; i < b.N; i++ {
+				naiveMultiply(xRows, yRows, n)
+			}
+		})
+		b.Run(fmt.Sprintf("blocked/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				x.MultiplyMatrixWith(y, MultiplyOptions{Workers: 1})
+			}
+		})
+		b.Run(fmt.Sprintf("parallel/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				x.MultiplyMatrixWith(y, MultiplyOptions{})
+			}
+		})
+	}
+}
+
+// Возвращает произведение матриц по определению: тройной цикл в порядке
+// i-k-j по срезам строк без разбиения на блоки и горутин.
+func naiveMultiply(a [][]float64, b [][]float64, columns int) [][]float64 {
+	result := make([][]float64, len(a))
+	for i := range a {
+		result[i] = make([]float64, columns)
+		for k, factor := range a[i] {
+			for j, value := range b[k] {
+				result[i][j] += factor * value
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,155 @@
+package matrices
+
+import "math"
+
+// Вид нормы матрицы.
+type NormKind byte
+
+const (
+	// Норма Фробениуса: корень из суммы квадратов элементов.
+	NormFrobenius NormKind = iota
+	// 1-норма: наибольшая сумма модулей элементов столбца.
+	NormOne
+	// ∞-норма: наибольшая сумма модулей элементов строки.
+	NormInfinity
+	// Спектральная норма (2-норма): наибольшее сингулярное число.
+	NormTwo
+)
+
+// Возвращает норму матрицы заданного вида.
+//
+// Возвращает ошибку, если вид нормы неизвестен или для спектральной нормы
+// не сошлось сингулярное разложение.
+func (m Matrix) Norm(kind NormKind) (float64, error) {
+	switch kind {
+	case NormFrobenius:
+		return frobeniusNorm(m.rowSlices()), nil
+	case NormOne:
+		return m.Transpose().infinityNorm(), nil
+	case NormInfinity:
+		return m.infinityNorm(), nil
+	case NormTwo:
+		return m.SpectralNorm()
+	}
+	return 0, UnknownMethodError(byte(kind))
+}
+
+// Возвращает оценку числа обусловленности квадратной матрицы в 1-норме:
+// κ₁(A) = ||A||₁ ||A⁻¹||₁. Норма обратной матрицы оценивается методом
+// Хейгера по LU-разложению, без вычисления самой обратной матрицы. Оценка
+// не больше точного значения и обычно совпадает с ним.
+//
+// Для вырожденной матрицы возвращается +Inf.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m Matrix) ConditionEstimate() (float64, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return 0, NotSquareMatrixError()
+	}
+	if m.rows == 0 {
+		return 0, nil
+	}
+
+	lu, pivot := m.decomposeLU()
+	if pivot != -1 && lu.U.row(pivot)[pivot] == 0 {
+		return math.Inf(1), nil
+	}
+	norm, _ := m.Norm(NormOne)
+	return norm * lu.inverseNormEstimate(), nil
+}
+
+// Возвращает наибольшую сумму модулей элементов строки.
+func (m Matrix) infinityNorm() float64 {
+	norm := 0.0
+	for i := 0; i < m.rows; i++ {
+		sum := 0.0
+		for j := 0; j < m.columns; j++ {
+			sum += math.Abs(m.row(i)[j])
+		}
+		norm = max(norm, sum)
+	}
+	return norm
+}
+
+// Возвращает предупреждение о плохой обусловленности, если число
+// обусловленности больше ConditionWarning, иначе nil.
+func conditionWarning(condition float64) error {
+	if condition > ConditionWarning {
+		return IllConditionedError(condition)
+	}
+	return nil
+}
+
+// Оценивает ||A⁻¹||₁ методом Хейгера: ищет вектор x с ||x||₁ = 1, на
+// котором ||A⁻¹x||₁ наибольшая, переходя к вершинам единичного шара
+// 1-нормы, пока значение растёт.
+func (d LUDecomposition) inverseNormEstimate() float64 {
+	n := d.U.rows
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+
+	estimate := 0.0
+	for iteration := 0; iteration < 5; iteration++ {
+		y, _ := d.Solve(x)
+		norm := 0.0
+		sign := make([]float64, n)
+		for i := range y {
+			norm += math.Abs(y[i])
+			sign[i] = math.Copysign(1, y[i])
+		}
+		if norm <= estimate {
+			break
+		}
+		estimate = norm
+
+		// z = A⁻ᵀ sign(y) - субградиент ||A⁻¹x||₁
+		z := d.solveTransposed(sign)
+		largest, product := 0, 0.0
+		for i := range z {
+			product += z[i] * x[i]
+			if math.Abs(z[i]) > math.Abs(z[largest]) {
+				largest = i
+			}
+		}
+		if math.Abs(z[largest]) <= product {
+			break
+		}
+		x = make([]float64, n)
+		x[largest] = 1
+	}
+	return estimate
+}
+
+// Возвращает решение системы Aᵀx = b. Так как Aᵀ = UᵀLᵀP, сначала решается
+// Uᵀw = b, затем Lᵀv = w, и x получается перестановкой v.
+func (d LUDecomposition) solveTransposed(b []float64) []float64 {
+	n := d.U.rows
+
+	// Прямая подстановка с нижнетреугольной матрицей Uᵀ
+	w := make([]float64, n)
+	for i := 0; i < n; i++ {
+		w[i] = b[i]
+		for j := 0; j < i; j++ {
+			w[i] -= d.U.row(j)[i] * w[j]
+		}
+		w[i] /= d.U.row(i)[i]
+	}
+
+	// Обратная подстановка с верхнетреугольной матрицей Lᵀ
+	v := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		v[i] = w[i]
+		for j := i + 1; j < n; j++ {
+			v[i] -= d.L.row(j)[i] * v[j]
+		}
+	}
+
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		x[d.pivotRow(i)] = v[i]
+	}
+	return x
+}
@@ -0,0 +1,121 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Нормы матрицы
+func TestNorm(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		kind     NormKind
+		want     float64
+	}{
+		{[][]float64{{1, -2}, {-3, 4}}, NormFrobenius, math.Sqrt(30)},
+		{[][]float64{{1, -2}, {-3, 4}}, NormOne, 6},
+		{[][]float64{{1, -2}, {-3, 4}}, NormInfinity, 7},
+		{[][]float64{{1, -2}, {-3, 4}}, NormTwo, math.Sqrt(15 + math.Sqrt(221))},
+		{[][]float64{{1, 2, 3}}, NormOne, 3},
+		{[][]float64{{1, 2, 3}}, NormInfinity, 6},
+		{[][]float64{{1, 2, 3}}, NormTwo, math.Sqrt(14)},
+		{[][]float64{}, NormFrobenius, 0},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v kind=%d", tt.elements, tt.kind)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			norm, err := matrix.Norm(tt.kind)
+			if err != nil {
+				t.Fatalf("got an error while calculating Norm: %v", err)
+			}
+			if math.Abs(norm-tt.want) > 1e-12 {
+				t.Errorf("got %g, want %g", norm, tt.want)
+			}
+		})
+	}
+
+	matrix, _ := NewMatrix([][]float64{{1}})
+	if _, err := matrix.Norm(4); err == nil || err.Error() != UnknownMethodError(4).Error() {
+		t.Errorf("got %v, want %v", err, UnknownMethodError(4))
+	}
+}
+
+// Оценка числа обусловленности
+func TestConditionEstimate(t *testing.T) {
+	tests := []struct {
+		matrix Matrix
+		want   float64
+	}{
+		{IdentityMatrix(3), 1},
+		// ||A||₁ = 6, ||A⁻¹||₁ = 3.5
+		{matrixFromRows(2, 2, [][]float64{{1, -2}, {-3, 4}}), 21},
+		{matrixFromRows(3, 3, [][]float64{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}}), 8},
+		{matrixFromRows(2, 2, [][]float64{{1, 2}, {2, 4}}), math.Inf(1)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.matrix.rowSlices())
+		t.Run(testname, func(t *testing.T) {
+			condition, err := tt.matrix.ConditionEstimate()
+			if err != nil {
+				t.Fatalf("got an error while calculating ConditionEstimate: %v", err)
+			}
+			if condition != tt.want && math.Abs(condition-tt.want) > 1e-9*tt.want {
+				t.Errorf("got %g, want %g", condition, tt.want)
+			}
+		})
+	}
+
+	// Для матрицы Гильберта оценка близка к точному значению κ₁ ≈ 3.387e10
+	condition, _ := hilbertMatrix(8).ConditionEstimate()
+	if condition < 3e10 || condition > 3.5e10 {
+		t.Errorf("got %g, want about 3.387e10", condition)
+	}
+
+	rectangular, _ := NewMatrix([][]float64{{1, 2}})
+	if _, err := rectangular.ConditionEstimate(); err == nil || err.Error() != NotSquareMatrixError().Error() {
+		t.Errorf("got %v, want %v", err, NotSquareMatrixError())
+	}
+}
+
+// Предупреждения о плохой обусловленности при решении систем
+func TestSolveConditionWarning(t *testing.T) {
+	well, _ := NewMatrix([][]float64{{2, 1}, {1, 3}})
+	solution, err := Solve(well, []float64{3, 5})
+	if err != nil {
+		t.Fatalf("got an error while solving: %v", err)
+	}
+	if solution.Warning != nil || solution.Condition <= 0 {
+		t.Errorf("got condition %g and warning %v, want no warning", solution.Condition, solution.Warning)
+	}
+
+	hilbert := hilbertMatrix(12)
+	b := make([]float64, hilbert.rows)
+	for i := range b {
+		b[i] = 1
+	}
+	solution, err = Solve(hilbert, b)
+	if err != nil {
+		t.Fatalf("got an error while solving: %v", err)
+	}
+	if solution.Kind == UniqueSolution && solution.Warning == nil {
+		t.Errorf("got condition %g without warning", solution.Condition)
+	}
+
+	// Число обусловленности матрицы Гильберта порядка 4 около 1.5e4, а для
+	// нормальных уравнений оно возводится в квадрат
+	squares, err := LeastSquaresBy(hilbertMatrix(4), b[:4], LeastSquaresNormal)
+	if err != nil {
+		t.Fatalf("got an error while solving: %v", err)
+	}
+	if squares.Warning == nil {
+		t.Errorf("got condition %g without warning for normal equations", squares.Condition)
+	}
+	squares, _ = LeastSquaresBy(hilbertMatrix(4), b[:4], LeastSquaresQR)
+	if squares.Warning != nil {
+		t.Errorf("got warning %v for QR", squares.Warning)
+	}
+}
@@ -0,0 +1,186 @@
+package matrices
+
+import "math/big"
+
+// Возвращает коэффициенты характеристического многочлена det(A - λE) в
+// порядке возрастания степеней: p(λ) = c[0] + c[1]λ + ... + c[n]λⁿ.
+// Многочлен вычисляется алгоритмом Берковица, который не использует
+// деление.
+//
+// Пример: для матрицы [[2 1] [1 2]] многочлен равен λ² - 4λ + 3, а
+// коэффициенты - [3 -4 1].
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m Matrix) CharacteristicPolynomial() ([]float64, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return nil, NotSquareMatrixError()
+	}
+	return characteristicElements(RealField{}, m.rowSlices(), m.rows), nil
+}
+
+// Возвращает точные коэффициенты характеристического многочлена
+// det(A - λE) в порядке возрастания степеней.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (r RationalMatrix) CharacteristicPolynomial() ([]*big.Rat, error) {
+	// Матрица должна быть квадратной
+	if r.rows != r.columns {
+		return nil, NotSquareMatrixError()
+	}
+	return characteristicElements(RationalField{}, r.elements, r.rows), nil
+}
+
+// Возвращает коэффициенты характеристического многочлена det(A - λE) в
+// порядке возрастания степеней.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m FieldMatrix[T]) CharacteristicPolynomial() ([]T, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return nil, NotSquareMatrixError()
+	}
+	return characteristicElements(m.field, m.elements, m.rows), nil
+}
+
+// Возвращает коэффициенты характеристического многочлена det(A - λE) по
+// модулю в порядке возрастания степеней. Алгоритм Берковица не использует
+// деление, поэтому модуль может быть составным.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m ModularMatrix) CharacteristicPolynomial() ([]int64, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return nil, NotSquareMatrixError()
+	}
+	return characteristicElements(m.field, m.elements, m.rows), nil
+}
+
+// Возвращает значение многочлена от матрицы:
+// p(A) = c[0]E + c[1]A + ... + c[k]Aᵏ, где коэффициенты заданы в порядке
+// возрастания степеней. Значение вычисляется по схеме Горнера.
+//
+// По теореме Гамильтона-Кэли значение характеристического многочлена от
+// матрицы равно нулевой матрице.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m Matrix) EvalPolynomial(coefficients []float64) (Matrix, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return Matrix{}, NotSquareMatrixError()
+	}
+
+	result := ZeroMatrix(m.rows, m.columns)
+	for k := len(coefficients) - 1; k >= 0; k-- {
+		// result = result * A + c[k] * E
+		result, _ = result.MultiplyMatrix(m)
+		term := IdentityMatrix(m.rows)
+		term.MultiplyByNumber(coefficients[k])
+		result.AddMatrix(term, false)
+	}
+	return result, nil
+}
+
+// Возвращает точное значение многочлена от матрицы, коэффициенты которого
+// заданы в порядке возрастания степеней.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (r RationalMatrix) EvalPolynomial(coefficients []*big.Rat) (RationalMatrix, error) {
+	// Матрица должна быть квадратной
+	if r.rows != r.columns {
+		return RationalMatrix{}, NotSquareMatrixError()
+	}
+
+	result := ZeroRationalMatrix(r.rows, r.columns)
+	for k := len(coefficients) - 1; k >= 0; k-- {
+		result, _ = result.MultiplyMatrix(r)
+		term := IdentityRationalMatrix(r.rows)
+		term.MultiplyByNumber(coefficients[k])
+		result.AddMatrix(term, false)
+	}
+	return result, nil
+}
+
+// Возвращает значение многочлена от матрицы над полем, коэффициенты
+// которого заданы в порядке возрастания степеней.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m FieldMatrix[T]) EvalPolynomial(coefficients []T) (FieldMatrix[T], error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return FieldMatrix[T]{}, NotSquareMatrixError()
+	}
+
+	result := ZeroFieldMatrix(m.field, m.rows, m.columns)
+	for k := len(coefficients) - 1; k >= 0; k-- {
+		result, _ = result.MultiplyMatrix(m)
+		term := IdentityFieldMatrix(m.field, m.rows)
+		term.MultiplyByNumber(coefficients[k])
+		result.AddMatrix(term, false)
+	}
+	return result, nil
+}
+
+// Возвращает коэффициенты многочлена det(A - λE) квадратной матрицы порядка
+// n в порядке возрастания степеней.
+//
+// Алгоритм Берковица: характеристический многочлен главной подматрицы
+// порядка k+1 получается умножением тёплицевой матрицы, составленной из
+// элементов -a, -RC, -RAC, -RA²C, ..., на многочлен подматрицы порядка k.
+// Здесь a - последний диагональный элемент, R и C - часть последней строки
+// и последнего столбца, A - подматрица порядка k.
+func characteristicElements[T any](ring Ring[T], elements [][]T, n int) []T {
+	if n == 0 {
+		return []T{ring.One()}
+	}
+
+	// Многочлен det(λE - A) левой верхней подматрицы порядка 1 в порядке
+	// убывания степеней
+	polynomial := []T{ring.One(), ring.Neg(elements[0][0])}
+	for k := 1; k < n; k++ {
+		// Коэффициенты тёплицевой матрицы: 1, -a, -RC, -RAC, ...
+		items := []T{ring.One(), ring.Neg(elements[k][k])}
+		vector := make([]T, k)
+		for i := 0; i < k; i++ {
+			vector[i] = elements[i][k]
+		}
+		for step := 0; step < k; step++ {
+			if step > 0 {
+				// vector = A * vector
+				next := make([]T, k)
+				for i := 0; i < k; i++ {
+					next[i] = ring.Zero()
+					for j := 0; j < k; j++ {
+						next[i] = ring.Add(next[i], ring.Mul(elements[i][j], vector[j]))
+					}
+				}
+				vector = next
+			}
+			product := ring.Zero()
+			for j := 0; j < k; j++ {
+				product = ring.Add(product, ring.Mul(elements[k][j], vector[j]))
+			}
+			items = append(items, ring.Neg(product))
+		}
+
+		// Умножаем тёплицеву матрицу размера (k+2)x(k+1) на многочлен
+		next := make([]T, k+2)
+		for i := range next {
+			next[i] = ring.Zero()
+			for j := 0; j <= min(i, k); j++ {
+				next[i] = ring.Add(next[i], ring.Mul(items[i-j], polynomial[j]))
+			}
+		}
+		polynomial = next
+	}
+
+	// det(A - λE) = (-1)ⁿ det(λE - A), коэффициенты записываем по возрастанию
+	coefficients := make([]T, n+1)
+	for i := range polynomial {
+		coefficients[n-i] = polynomial[i]
+		if n%2 == 1 {
+			coefficients[n-i] = ring.Neg(polynomial[i])
+		}
+	}
+	return coefficients
+}
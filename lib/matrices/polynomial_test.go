@@ -0,0 +1,127 @@
+package matrices
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+// Характеристический многочлен матрицы действительных чисел
+func TestCharacteristicPolynomial(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		want     []float64
+	}{
+		{[][]float64{}, []float64{1}},
+		{[][]float64{{5}}, []float64{5, -1}},
+		{[][]float64{{2, 1}, {1, 2}}, []float64{3, -4, 1}},
+		{[][]float64{{0, -1}, {1, 0}}, []float64{1, 0, 1}},
+		{[][]float64{{2, 0, 0}, {0, 3, 4}, {0, 4, 9}}, []float64{22, -35, 14, -1}},
+		{[][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, []float64{0, 18, 15, -1}},
+		{[][]float64{{10, -35, 50, -24}, {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}}, []float64{24, -50, 35, -10, 1}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			coefficients, err := matrix.CharacteristicPolynomial()
+			if err != nil {
+				t.Fatalf("got an error while calculating CharacteristicPolynomial: %v", err)
+			}
+			if !approximatelyEqual([][]float64{coefficients}, [][]float64{tt.want}, 1e-9) {
+				t.Errorf("got %v, want %v", coefficients, tt.want)
+			}
+
+			// Теорема Гамильтона-Кэли: p(A) = 0
+			value, err := matrix.EvalPolynomial(coefficients)
+			if err != nil {
+				t.Fatalf("got an error while calculating EvalPolynomial: %v", err)
+			}
+			if !approximatelyEqual(value.rowSlices(), ZeroMatrix(matrix.rows, matrix.columns).rowSlices(), 1e-9) {
+				t.Errorf("got p(A) = %v, want zero matrix", value.rowSlices())
+			}
+		})
+	}
+
+	matrix, _ := NewMatrix([][]float64{{1, 2}})
+	if _, err := matrix.CharacteristicPolynomial(); err == nil || err.Error() != NotSquareMatrixError().Error() {
+		t.Errorf("got %v, want %v", err, NotSquareMatrixError())
+	}
+}
+
+// Значение многочлена от матрицы
+func TestEvalPolynomial(t *testing.T) {
+	matrix, _ := NewMatrix([][]float64{{1, 1}, {0, 1}})
+	tests := []struct {
+		coefficients []float64
+		want         [][]float64
+	}{
+		{[]float64{}, [][]float64{{0, 0}, {0, 0}}},
+		{[]float64{3}, [][]float64{{3, 0}, {0, 3}}},
+		{[]float64{0, 1}, [][]float64{{1, 1}, {0, 1}}},
+		// A² - 2A + E = (A - E)² = 0
+		{[]float64{1, -2, 1}, [][]float64{{0, 0}, {0, 0}}},
+		{[]float64{1, 0, 0, 2}, [][]float64{{3, 6}, {0, 3}}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.coefficients)
+		t.Run(testname, func(t *testing.T) {
+			value, err := matrix.EvalPolynomial(tt.coefficients)
+			if err != nil {
+				t.Fatalf("got an error while calculating EvalPolynomial: %v", err)
+			}
+			if fmt.Sprintf("%v", value.rowSlices()) != fmt.Sprintf("%v", tt.want) {
+				t.Errorf("got %v, want %v", value.rowSlices(), tt.want)
+			}
+		})
+	}
+}
+
+// Точный характеристический многочлен рациональной матрицы
+func TestRationalCharacteristicPolynomial(t *testing.T) {
+	matrix, _ := NewRationalMatrix([][]*big.Rat{
+		{big.NewRat(1, 2), big.NewRat(1, 3)},
+		{big.NewRat(1, 4), big.NewRat(1, 5)},
+	})
+	coefficients, err := matrix.CharacteristicPolynomial()
+	if err != nil {
+		t.Fatalf("got an error while calculating CharacteristicPolynomial: %v", err)
+	}
+	// λ² - 7/10 λ + 1/60
+	want := "[[1/60 -7/10 1]]"
+	if got := fmt.Sprintf("%v", ratStrings(RationalMatrix{1, 3, [][]*big.Rat{coefficients}})); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	value, err := matrix.EvalPolynomial(coefficients)
+	if err != nil {
+		t.Fatalf("got an error while calculating EvalPolynomial: %v", err)
+	}
+	if got, want := fmt.Sprintf("%v", ratStrings(value)), "[[0 0] [0 0]]"; got != want {
+		t.Errorf("got p(A) = %v, want %v", got, want)
+	}
+}
+
+// Характеристический многочлен по составному модулю
+func TestModularCharacteristicPolynomial(t *testing.T) {
+	matrix, _ := NewModularMatrix([][]int64{{6, 24, 1}, {13, 16, 10}, {20, 17, 15}}, 26)
+	coefficients, err := matrix.CharacteristicPolynomial()
+	if err != nil {
+		t.Fatalf("got an error while calculating CharacteristicPolynomial: %v", err)
+	}
+	// Свободный член равен определителю
+	determinator, _ := matrix.Determinator()
+	if coefficients[0] != determinator {
+		t.Errorf("got constant term %d, want %d", coefficients[0], determinator)
+	}
+	// Старший коэффициент равен (-1)³
+	if coefficients[3] != 25 {
+		t.Errorf("got leading coefficient %d, want 25", coefficients[3])
+	}
+	// След матрицы: коэффициент при λ² равен (-1)² * tr A
+	if coefficients[2] != 11 {
+		t.Errorf("got coefficient %d, want 11", coefficients[2])
+	}
+}
@@ -0,0 +1,145 @@
+package matrices
+
+import "math"
+
+// Возвращает k-ю степень квадратной матрицы, вычисленную быстрым
+// возведением в степень: Aᵏ = (A^(k/2))² для чётного k и A * Aᵏ⁻¹ для
+// нечётного, всего O(log k) умножений. Для отрицательного k возводится в
+// степень обратная матрица, A⁰ = E.
+//
+// Возвращает ошибку, если матрица не квадратная или k отрицательно, а
+// матрица вырожденная.
+func (m Matrix) Power(k int) (Matrix, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return Matrix{}, NotSquareMatrixError()
+	}
+
+	base, exponent := m, uint(k)
+	if k < 0 {
+		inverse, err := m.Inverse()
+		if err != nil {
+			return Matrix{}, err
+		}
+		base, exponent = inverse, -exponent
+	}
+	return matrixFromRows(m.rows, m.columns, powerElements(RealField{}, base.rowSlices(), m.rows, exponent)), nil
+}
+
+// Возвращает k-ю степень квадратной матрицы, вычисленную точно. Для
+// отрицательного k возводится в степень обратная матрица.
+//
+// Возвращает ошибку, если матрица не квадратная или k отрицательно, а
+// матрица вырожденная.
+func (r RationalMatrix) Power(k int) (RationalMatrix, error) {
+	// Матрица должна быть квадратной
+	if r.rows != r.columns {
+		return RationalMatrix{}, NotSquareMatrixError()
+	}
+
+	base, exponent := r, uint(k)
+	if k < 0 {
+		inverse, err := r.Inverse()
+		if err != nil {
+			return RationalMatrix{}, err
+		}
+		base, exponent = inverse, -exponent
+	}
+	return RationalMatrix{r.rows, r.columns, powerElements(RationalField{}, base.elements, r.rows, exponent)}, nil
+}
+
+// Возвращает k-ю степень квадратной матрицы по модулю. Для отрицательного k
+// возводится в степень обратная по модулю матрица.
+//
+// Пример: степени матрицы [[1 1] [1 0]] содержат числа Фибоначчи,
+// поэтому F(n) mod p находится за O(log n) умножений.
+//
+// Возвращает ошибку, если матрица не квадратная или k отрицательно, а
+// матрица необратима по модулю.
+func (m ModularMatrix) Power(k int) (ModularMatrix, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return ModularMatrix{}, NotSquareMatrixError()
+	}
+
+	base, exponent := m, uint(k)
+	if k < 0 {
+		inverse, err := m.Inverse()
+		if err != nil {
+			return ModularMatrix{}, err
+		}
+		base, exponent = inverse, -exponent
+	}
+	return ModularMatrix{m.field, m.rows, m.columns, powerElements(m.field, base.elements, m.rows, exponent)}, nil
+}
+
+// Возвращает матричную экспоненту e^A = E + A + A²/2! + ... методом
+// масштабирования и возведения в квадрат: e^A = (e^(A/2ʲ))^(2ʲ), где j
+// выбирается так, чтобы ||A/2ʲ||∞ ≤ 1/2, а e^(A/2ʲ) приближается
+// аппроксимацией Паде порядка (6, 6).
+//
+// Решение системы линейных дифференциальных уравнений x' = Ax с начальным
+// условием x(0) = x₀ равно x(t) = e^(At)x₀.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (m Matrix) Exp() (Matrix, error) {
+	// Матрица должна быть квадратной
+	if m.rows != m.columns {
+		return Matrix{}, NotSquareMatrixError()
+	}
+	n := m.rows
+
+	// Масштабирование
+	squarings := 0
+	if norm := m.infinityNorm(); norm > 0.5 {
+		squarings = int(math.Ceil(math.Log2(norm / 0.5)))
+	}
+	a := m.clone()
+	a.DivideByNumber(math.Ldexp(1, squarings))
+
+	// Аппроксимация Паде: e^A ≈ D⁻¹N, N = Σ cₖAᵏ, D = Σ cₖ(-A)ᵏ
+	const order = 6
+	numerator := IdentityMatrix(n)
+	denominator := IdentityMatrix(n)
+	power := IdentityMatrix(n)
+	c := 1.0
+	for k := 1; k <= order; k++ {
+		c *= float64(order-k+1) / float64(k*(2*order-k+1))
+		power, _ = power.MultiplyMatrix(a)
+		term := power.clone()
+		term.MultiplyByNumber(c)
+		numerator.AddMatrix(term, false)
+		denominator.AddMatrix(term, k%2 == 1)
+	}
+	inverse, err := denominator.Inverse()
+	if err != nil {
+		return Matrix{}, err
+	}
+	result, _ := inverse.MultiplyMatrix(numerator)
+
+	// Возведение в квадрат
+	for i := 0; i < squarings; i++ {
+		result, _ = result.MultiplyMatrix(result)
+	}
+	return result, nil
+}
+
+// Возвращает k-ю степень квадратной матрицы порядка n, вычисленную быстрым
+// возведением в степень. Показатель беззнаковый: модуль math.MinInt не
+// помещается в int, поэтому вызывающие функции передают -uint(k).
+func powerElements[T any](ring Ring[T], elements [][]T, n int, k uint) [][]T {
+	result := zeroElements(ring, n, n)
+	for i := 0; i < n; i++ {
+		result[i][i] = ring.One()
+	}
+	base := elements
+	for ; k > 0; k /= 2 {
+		if k%2 == 1 {
+			result = multiplyElements(ring, result, base, n, n, n)
+		}
+		if k > 1 {
+			base = multiplyElements(ring, base, base, n, n, n)
+		}
+	}
+	return result
+}
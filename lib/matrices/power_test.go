@@ -0,0 +1,153 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// Степень матрицы
+func TestPower(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		k        int
+		want     [][]float64
+		wantErr  error
+	}{
+		{[][]float64{{1, 1}, {1, 0}}, 0, [][]float64{{1, 0}, {0, 1}}, nil},
+		{[][]float64{{1, 1}, {1, 0}}, 1, [][]float64{{1, 1}, {1, 0}}, nil},
+		{[][]float64{{1, 1}, {1, 0}}, 10, [][]float64{{89, 55}, {55, 34}}, nil},
+		{[][]float64{{2, 0}, {0, 4}}, -2, [][]float64{{0.25, 0}, {0, 0.0625}}, nil},
+		{[][]float64{{1, 1}, {0, 1}}, -3, [][]float64{{1, -3}, {0, 1}}, nil},
+		// Цепь Маркова приходит к стационарному распределению (5/6, 1/6)
+		{[][]float64{{0.9, 0.1}, {0.5, 0.5}}, 100, [][]float64{{5.0 / 6, 1.0 / 6}, {5.0 / 6, 1.0 / 6}}, nil},
+		// Модуль math.MinInt не помещается в int
+		{[][]float64{{2, 0}, {0, 1}}, math.MinInt, [][]float64{{0, 0}, {0, 1}}, nil},
+		{[][]float64{{1, 2}, {2, 4}}, -1, nil, SingularMatrixError(2)},
+		{[][]float64{{1, 2}, {2, 4}}, math.MinInt, nil, SingularMatrixError(2)},
+		{[][]float64{{1, 2}}, 2, nil, NotSquareMatrixError()},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v^%d", tt.elements, tt.k)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			power, err := matrix.Power(tt.k)
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while calculating Power: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err == nil && !approximatelyEqual(power.rowSlices(), tt.want, 1e-12) {
+				t.Errorf("got %v, want %v", power.rowSlices(), tt.want)
+			}
+		})
+	}
+}
+
+// Точная степень рациональной матрицы и степень по модулю
+func TestExactPower(t *testing.T) {
+	rational, _ := NewIntegerRationalMatrix([][]int64{{1, 1}, {1, 0}})
+	power, err := rational.Power(100)
+	if err != nil {
+		t.Fatalf("got an error while calculating Power: %v", err)
+	}
+	// F(100) не помещается в int64
+	if got, want := power.At(0, 1).RatString(), "354224848179261915075"; got != want {
+		t.Errorf("got F(100) = %s, want %s", got, want)
+	}
+
+	half, _ := NewRationalMatrix([][]*big.Rat{{big.NewRat(1, 2), big.NewRat(0, 1)}, {big.NewRat(0, 1), big.NewRat(3, 1)}})
+	power, _ = half.Power(-3)
+	if got, want := fmt.Sprintf("%v", ratStrings(power)), "[[8 0] [0 1/27]]"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	modular, _ := NewModularMatrix([][]int64{{1, 1}, {1, 0}}, 1000000007)
+	modularPower, err := modular.Power(100)
+	if err != nil {
+		t.Fatalf("got an error while calculating Power: %v", err)
+	}
+	if got, want := fmt.Sprintf("%v", modularPower.elements), "[[782204094 687995182] [687995182 94208912]]"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// 2⁻¹ = 4 (mod 7), 4³ = 1 (mod 7), 2⁶³ = 2 (mod 3), поэтому
+	// 2^math.MinInt = 4² = 2 (mod 7)
+	doubling, _ := NewModularMatrix([][]int64{{2, 0}, {0, 1}}, 7)
+	modularPower, err = doubling.Power(math.MinInt)
+	if err != nil {
+		t.Fatalf("got an error while calculating Power: %v", err)
+	}
+	if got, want := fmt.Sprintf("%v", modularPower.elements), "[[2 0] [0 1]]"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// Обратная степень по составному модулю
+	hill, _ := NewModularMatrix([][]int64{{3, 3}, {2, 5}}, 26)
+	modularPower, _ = hill.Power(-1)
+	if got, want := fmt.Sprintf("%v", modularPower.elements), "[[15 17] [20 9]]"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// Матричная экспонента
+func TestExp(t *testing.T) {
+	angle := 2.0
+	tests := []struct {
+		elements [][]float64
+		want     [][]float64
+	}{
+		{[][]float64{{0, 0}, {0, 0}}, [][]float64{{1, 0}, {0, 1}}},
+		{[][]float64{{1, 0}, {0, -2}}, [][]float64{{math.E, 0}, {0, math.Exp(-2)}}},
+		// Нильпотентная матрица: e^A = E + A
+		{[][]float64{{0, 1}, {0, 0}}, [][]float64{{1, 1}, {0, 1}}},
+		// Поворот на угол
+		{[][]float64{{0, -angle}, {angle, 0}}, [][]float64{{math.Cos(angle), -math.Sin(angle)}, {math.Sin(angle), math.Cos(angle)}}},
+		{
+			[][]float64{{1, 2}, {3, 4}},
+			[][]float64{{51.968956198705044, 74.73656456700328}, {112.10484685050491, 164.07380304920997}},
+		},
+		// Жорданова клетка: e^(λE + N) = e^λ(E + N + N²/2)
+		{
+			[][]float64{{3, 1, 0}, {0, 3, 1}, {0, 0, 3}},
+			[][]float64{{math.Exp(3), math.Exp(3), math.Exp(3) / 2}, {0, math.Exp(3), math.Exp(3)}, {0, 0, math.Exp(3)}},
+		},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			exp, err := matrix.Exp()
+			if err != nil {
+				t.Fatalf("got an error while calculating Exp: %v", err)
+			}
+			// Относительная точность
+			scale := max(1, frobeniusNorm(tt.want))
+			if !approximatelyEqual(exp.rowSlices(), tt.want, 1e-12*scale) {
+				t.Errorf("got %v, want %v", exp.rowSlices(), tt.want)
+			}
+		})
+	}
+
+	matrix, _ := NewMatrix([][]float64{{1, 2}})
+	if _, err := matrix.Exp(); err == nil || err.Error() != NotSquareMatrixError().Error() {
+		t.Errorf("got %v, want %v", err, NotSquareMatrixError())
+	}
+}
+
+// Многочлен от матрицы над полем
+func TestFieldMatrixEvalPolynomial(t *testing.T) {
+	matrix, _ := NewFieldMatrix[complex128](ComplexField{}, [][]complex128{{0, -1}, {1, 0}})
+	// A² + E = 0, так как собственные значения равны ±i
+	value, err := matrix.EvalPolynomial([]complex128{1, 0, 1})
+	if err != nil {
+		t.Fatalf("got an error while calculating EvalPolynomial: %v", err)
+	}
+	if got, want := fmt.Sprintf("%v", value.elements), "[[(0+0i) (0+0i)] [(0+0i) (0+0i)]]"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,181 @@
+package matrices
+
+// Возвращает кронекерово произведение матриц A ⊗ B: блочную матрицу, блок
+// (i, j) которой равен a[i][j] * B. Размер результата - (m*p)x(n*q) для
+// матриц размеров mxn и pxq.
+func (m Matrix) Kronecker(other Matrix) Matrix {
+	result := ZeroMatrix(m.rows*other.rows, m.columns*other.columns)
+	for i := 0; i < m.rows; i++ {
+		for k := 0; k < other.rows; k++ {
+			row, otherRow := result.row(i*other.rows+k), other.row(k)
+			for j, factor := range m.row(i) {
+				block := row[j*other.columns : (j+1)*other.columns]
+				for l, value := range otherRow {
+					block[l] = factor * value
+				}
+			}
+		}
+	}
+	return result
+}
+
+// Возвращает произведение Адамара: матрицу из произведений соответствующих
+// элементов матриц одного размера.
+//
+// Возвращает ошибку, если у матриц разные размеры.
+func (m Matrix) HadamardProduct(other Matrix) (Matrix, error) {
+	if m.rows != other.rows || m.columns != other.columns {
+		return Matrix{}, NotSameSizeError(m.rows, m.columns, other.rows, other.columns)
+	}
+	result := ZeroMatrix(m.rows, m.columns)
+	for i := 0; i < m.rows; i++ {
+		row, aRow, bRow := result.row(i), m.row(i), other.row(i)
+		for j := range row {
+			row[j] = aRow[j] * bRow[j]
+		}
+	}
+	return result, nil
+}
+
+// Возвращает матрицу из частных соответствующих элементов матриц одного
+// размера (деление Адамара).
+//
+// Возвращает ошибку, если у матриц разные размеры или в делителе есть
+// нулевой элемент.
+func (m Matrix) HadamardDivision(other Matrix) (Matrix, error) {
+	if m.rows != other.rows || m.columns != other.columns {
+		return Matrix{}, NotSameSizeError(m.rows, m.columns, other.rows, other.columns)
+	}
+	result := ZeroMatrix(m.rows, m.columns)
+	for i := 0; i < m.rows; i++ {
+		row, aRow, bRow := result.row(i), m.row(i), other.row(i)
+		for j := range row {
+			if bRow[j] == 0 {
+				return Matrix{}, DivisionByZeroError()
+			}
+			row[j] = aRow[j] / bRow[j]
+		}
+	}
+	return result, nil
+}
+
+// Возвращает внешнее произведение векторов uvᵀ: матрицу размера
+// len(u)xlen(v), элемент (i, j) которой равен u[i] * v[j].
+func Outer(u []float64, v []float64) Matrix {
+	result := ZeroMatrix(len(u), len(v))
+	for i := range u {
+		row := result.row(i)
+		for j := range v {
+			row[j] = u[i] * v[j]
+		}
+	}
+	return result
+}
+
+// Возвращает векторизацию матрицы vec(A): столбцы матрицы, записанные друг
+// за другом в один вектор.
+func (m Matrix) Vec() []float64 {
+	result := make([]float64, 0, m.rows*m.columns)
+	for j := 0; j < m.columns; j++ {
+		for i := 0; i < m.rows; i++ {
+			result = append(result, m.row(i)[j])
+		}
+	}
+	return result
+}
+
+// Возвращает матрицу заданного размера, столбцы которой записаны в векторе
+// друг за другом. Функция обратна Vec: Unvec(A.Vec(), A.Rows(), A.Columns())
+// равна A.
+//
+// Возвращает ошибку, если размеры отрицательны или длина вектора не равна
+// rows*columns.
+func Unvec(vector []float64, rows int, columns int) (Matrix, error) {
+	if rows < 0 || columns < 0 {
+		return Matrix{}, InvalidSizeError(rows, columns)
+	}
+	if len(vector) != rows*columns {
+		return Matrix{}, NotSameSizeError(rows, columns, len(vector), 1)
+	}
+	result := ZeroMatrix(rows, columns)
+	for j := 0; j < columns; j++ {
+		for i := 0; i < rows; i++ {
+			result.row(i)[j] = vector[j*rows+i]
+		}
+	}
+	return result, nil
+}
+
+// Возвращает кронекерово произведение матриц над полем.
+func (m FieldMatrix[T]) Kronecker(other FieldMatrix[T]) FieldMatrix[T] {
+	elements := kroneckerElements(m.field, m.elements, other.elements, m.columns, other.columns)
+	return FieldMatrix[T]{m.field, m.rows * other.rows, m.columns * other.columns, elements}
+}
+
+// Возвращает произведение Адамара матриц над полем.
+//
+// Возвращает ошибку, если у матриц разные размеры.
+func (m FieldMatrix[T]) HadamardProduct(other FieldMatrix[T]) (FieldMatrix[T], error) {
+	if m.rows != other.rows || m.columns != other.columns {
+		return FieldMatrix[T]{}, NotSameSizeError(m.rows, m.columns, other.rows, other.columns)
+	}
+	elements, _ := hadamardElements(m.field, m.elements, other.elements, false)
+	return FieldMatrix[T]{m.field, m.rows, m.columns, elements}, nil
+}
+
+// Возвращает матрицу из частных соответствующих элементов матриц над полем.
+//
+// Возвращает ошибку, если у матриц разные размеры или в делителе есть
+// нулевой или необратимый элемент (в кольце вычетов по составному модулю).
+func (m FieldMatrix[T]) HadamardDivision(other FieldMatrix[T]) (FieldMatrix[T], error) {
+	if m.rows != other.rows || m.columns != other.columns {
+		return FieldMatrix[T]{}, NotSameSizeError(m.rows, m.columns, other.rows, other.columns)
+	}
+	elements, err := hadamardElements(m.field, m.elements, other.elements, true)
+	if err != nil {
+		return FieldMatrix[T]{}, err
+	}
+	return FieldMatrix[T]{m.field, m.rows, m.columns, elements}, nil
+}
+
+// Возвращает элементы кронекерова произведения матриц с заданным
+// количеством столбцов.
+func kroneckerElements[T any](ring Ring[T], a [][]T, b [][]T, aColumns int, bColumns int) [][]T {
+	result := zeroElements(ring, len(a)*len(b), aColumns*bColumns)
+	for i := range a {
+		for j := 0; j < aColumns; j++ {
+			for k := range b {
+				row := result[i*len(b)+k]
+				for l := 0; l < bColumns; l++ {
+					row[j*bColumns+l] = ring.Mul(a[i][j], b[k][l])
+				}
+			}
+		}
+	}
+	return result
+}
+
+// Возвращает произведения (или частные, если divide равен true)
+// соответствующих элементов матриц одного размера.
+//
+// Возвращает ошибку, если при делении в делителе есть нулевой или
+// необратимый элемент.
+func hadamardElements[T any](field Field[T], a [][]T, b [][]T, divide bool) ([][]T, error) {
+	result := cloneElements(a)
+	for i := range result {
+		for j := range result[i] {
+			if !divide {
+				result[i][j] = field.Mul(a[i][j], b[i][j])
+				continue
+			}
+			if field.IsZero(b[i][j]) {
+				return nil, DivisionByZeroError()
+			}
+			if !field.IsInvertible(b[i][j]) {
+				return nil, NotInvertibleElementError(field.Format(b[i][j]))
+			}
+			result[i][j] = field.Div(a[i][j], b[i][j])
+		}
+	}
+	return result, nil
+}
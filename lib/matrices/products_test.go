@@ -0,0 +1,196 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// Кронекерово произведение
+func TestKronecker(t *testing.T) {
+	tests := []struct {
+		a    [][]float64
+		b    [][]float64
+		want [][]float64
+	}{
+		{[][]float64{{2}}, [][]float64{{1, 2}, {3, 4}}, [][]float64{{2, 4}, {6, 8}}},
+		{
+			[][]float64{{1, 2}, {3, 4}}, [][]float64{{0, 5}, {6, 7}},
+			[][]float64{{0, 5, 0, 10}, {6, 7, 12, 14}, {0, 15, 0, 20}, {18, 21, 24, 28}},
+		},
+		{[][]float64{{1, -1}}, [][]float64{{1}, {2}}, [][]float64{{1, -1}, {2, -2}}},
+		{[][]float64{{1}, {2}}, [][]float64{{1, 0, 3}}, [][]float64{{1, 0, 3}, {2, 0, 6}}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v,%v", tt.a, tt.b)
+		t.Run(testname, func(t *testing.T) {
+			a, _ := NewMatrix(tt.a)
+			b, _ := NewMatrix(tt.b)
+			if got := a.Kronecker(b).Elements(); !approximatelyEqual(got, tt.want, 0) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Свойство смешанного произведения: (A ⊗ B)(C ⊗ D) = AC ⊗ BD
+	a, _ := NewMatrix([][]float64{{1, 2}, {3, 4}})
+	b, _ := NewMatrix([][]float64{{0, 1}, {1, 0}})
+	c, _ := NewMatrix([][]float64{{2, 0}, {1, 1}})
+	d, _ := NewMatrix([][]float64{{1, 1}, {0, 2}})
+	left, _ := a.Kronecker(b).MultiplyMatrix(c.Kronecker(d))
+	ac, _ := a.MultiplyMatrix(c)
+	bd, _ := b.MultiplyMatrix(d)
+	if right := ac.Kronecker(bd); !approximatelyEqual(left.Elements(), right.Elements(), 0) {
+		t.Errorf("got %v, want %v", left.Elements(), right.Elements())
+	}
+
+	// Состояние двух кубитов: H ⊗ Y над комплексными числами
+	hadamard, _ := NewFieldMatrix[complex128](ComplexField{}, [][]complex128{{1, 1}, {1, -1}})
+	pauliY, _ := NewFieldMatrix[complex128](ComplexField{}, [][]complex128{{0, -1i}, {1i, 0}})
+	gate := hadamard.Kronecker(pauliY)
+	want := "[[(0+0i) (0-1i) (0+0i) (0-1i)] [(0+1i) (0+0i) (0+1i) (0+0i)] [(0+0i) (0-1i) (-0+0i) (0+1i)] [(0+1i) (0+0i) (-0-1i) (-0+0i)]]"
+	if fmt.Sprint(gate.elements) != want {
+		t.Errorf("got %v, want %v", gate.elements, want)
+	}
+	if gate.Rows() != 4 || gate.Columns() != 4 {
+		t.Errorf("got size %dx%d, want 4x4", gate.Rows(), gate.Columns())
+	}
+}
+
+// Произведение и деление Адамара
+func TestHadamard(t *testing.T) {
+	tests := []struct {
+		a        [][]float64
+		b        [][]float64
+		product  [][]float64
+		quotient [][]float64
+		wantErr  error
+	}{
+		{
+			[][]float64{{1, 2}, {3, 4}}, [][]float64{{5, -2}, {0.5, 8}},
+			[][]float64{{5, -4}, {1.5, 32}}, [][]float64{{0.2, -1}, {6, 0.5}}, nil,
+		},
+		{[][]float64{{1, 2}}, [][]float64{{3, 0}}, [][]float64{{3, 0}}, nil, DivisionByZeroError()},
+		{[][]float64{{1, 2}}, [][]float64{{1}, {2}}, nil, nil, NotSameSizeError(1, 2, 2, 1)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v,%v", tt.a, tt.b)
+		t.Run(testname, func(t *testing.T) {
+			a, _ := NewMatrix(tt.a)
+			b, _ := NewMatrix(tt.b)
+			product, err := a.HadamardProduct(b)
+			if tt.product == nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("got %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if !approximatelyEqual(product.Elements(), tt.product, 1e-15) {
+				t.Errorf("got product %v, want %v", product.Elements(), tt.product)
+			}
+
+			quotient, err := a.HadamardDivision(b)
+			if tt.quotient == nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("got %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if !approximatelyEqual(quotient.Elements(), tt.quotient, 1e-15) {
+				t.Errorf("got quotient %v, want %v", quotient.Elements(), tt.quotient)
+			}
+		})
+	}
+
+	// Точное деление над рациональными числами
+	a, _ := NewFieldMatrix[*big.Rat](RationalField{}, [][]*big.Rat{{big.NewRat(1, 2), big.NewRat(3, 1)}})
+	b, _ := NewFieldMatrix[*big.Rat](RationalField{}, [][]*big.Rat{{big.NewRat(1, 3), big.NewRat(-6, 1)}})
+	quotient, _ := a.HadamardDivision(b)
+	product, _ := a.HadamardProduct(b)
+	if got := ratStrings(RationalMatrix{1, 2, quotient.elements}); fmt.Sprint(got) != "[[3/2 -1/2]]" {
+		t.Errorf("got %v, want [[3/2 -1/2]]", got)
+	}
+	if got := ratStrings(RationalMatrix{1, 2, product.elements}); fmt.Sprint(got) != "[[1/6 -18]]" {
+		t.Errorf("got %v, want [[1/6 -18]]", got)
+	}
+	zero, _ := NewFieldMatrix[*big.Rat](RationalField{}, [][]*big.Rat{{big.NewRat(1, 1), new(big.Rat)}})
+	if _, err := a.HadamardDivision(zero); err == nil || err.Error() != DivisionByZeroError().Error() {
+		t.Errorf("got %v, want %v", err, DivisionByZeroError())
+	}
+
+	// По составному модулю на ненулевой необратимый элемент делить нельзя
+	composite := ModularField{26}
+	if _, err := hadamardElements(composite, [][]int64{{6, 5}}, [][]int64{{3, 13}}, true); err == nil || err.Error() != NotInvertibleElementError("13").Error() {
+		t.Errorf("got %v, want %v", err, NotInvertibleElementError("13"))
+	}
+	if quotient, err := hadamardElements(composite, [][]int64{{6, 5}}, [][]int64{{3, 5}}, true); err != nil || fmt.Sprint(quotient) != "[[2 1]]" {
+		t.Errorf("got %v, %v, want [[2 1]]", quotient, err)
+	}
+}
+
+// Внешнее произведение векторов
+func TestOuter(t *testing.T) {
+	outer := Outer([]float64{1, 2, 3}, []float64{4, -1})
+	want := [][]float64{{4, -1}, {8, -2}, {12, -3}}
+	if !approximatelyEqual(outer.Elements(), want, 0) {
+		t.Errorf("got %v, want %v", outer.Elements(), want)
+	}
+
+	// Ранг внешнего произведения ненулевых векторов равен единице
+	if rank := outer.Rank(); rank != 1 {
+		t.Errorf("got rank %d, want 1", rank)
+	}
+
+	// uvᵀ = u ⊗ vᵀ
+	kronecker := ColumnMatrix([]float64{1, 2, 3}).Kronecker(RowMatrix([]float64{4, -1}))
+	if !approximatelyEqual(kronecker.Elements(), want, 0) {
+		t.Errorf("got %v, want %v", kronecker.Elements(), want)
+	}
+}
+
+// Векторизация матрицы и обратное преобразование
+func TestVecUnvec(t *testing.T) {
+	matrix, _ := NewMatrix([][]float64{{1, 2, 3}, {4, 5, 6}})
+	vector := matrix.Vec()
+	if fmt.Sprint(vector) != "[1 4 2 5 3 6]" {
+		t.Errorf("got %v, want [1 4 2 5 3 6]", vector)
+	}
+	restored, err := Unvec(vector, 2, 3)
+	if err != nil {
+		t.Fatalf("got an error while calling Unvec: %v", err)
+	}
+	if !approximatelyEqual(restored.Elements(), matrix.Elements(), 0) {
+		t.Errorf("got %v, want %v", restored.Elements(), matrix.Elements())
+	}
+	reshaped, _ := Unvec(vector, 3, 2)
+	if !approximatelyEqual(reshaped.Elements(), [][]float64{{1, 5}, {4, 3}, {2, 6}}, 0) {
+		t.Errorf("got %v, want [[1 5] [4 3] [2 6]]", reshaped.Elements())
+	}
+	if _, err := Unvec(vector, 4, 2); err == nil || err.Error() != NotSameSizeError(4, 2, 6, 1).Error() {
+		t.Errorf("got %v, want %v", err, NotSameSizeError(4, 2, 6, 1))
+	}
+	// Отрицательные размеры: произведение может совпасть с длиной вектора
+	for _, size := range [][2]int{{-1, 0}, {-2, -3}, {0, -1}} {
+		if _, err := Unvec(nil, size[0], size[1]); err == nil || err.Error() != InvalidSizeError(size[0], size[1]).Error() {
+			t.Errorf("got %v, want %v", err, InvalidSizeError(size[0], size[1]))
+		}
+	}
+
+	// vec(AXB) = (Bᵀ ⊗ A) vec(X)
+	a, _ := NewMatrix([][]float64{{1, 2}, {0, 1}})
+	x, _ := NewMatrix([][]float64{{3, -1, 2}, {1, 4, 0}})
+	b, _ := NewMatrix([][]float64{{1, 0}, {2, 1}, {-1, 3}})
+	ax, _ := a.MultiplyMatrix(x)
+	axb, _ := ax.MultiplyMatrix(b)
+	right, _ := b.Transpose().Kronecker(a).MultiplyMatrix(ColumnMatrix(x.Vec()))
+	got, want := right.Column(0), axb.Vec()
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("got %v, want %v", got, want)
+			break
+		}
+	}
+}
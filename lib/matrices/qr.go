@@ -0,0 +1,190 @@
+package matrices
+
+import "math"
+
+// Способ вычисления QR-разложения.
+type QRMethod byte
+
+const (
+	// Отражения Хаусхолдера: наиболее устойчивый способ, работает для любых
+	// матриц.
+	QRHouseholder QRMethod = iota
+	// Классический процесс Грама-Шмидта: коэффициенты вычисляются по
+	// исходным столбцам, поэтому из-за ошибок округления столбцы Q могут
+	// терять ортогональность.
+	QRGramSchmidt
+	// Модифицированный процесс Грама-Шмидта: коэффициенты вычисляются по уже
+	// изменённым столбцам, что заметно уменьшает потерю ортогональности.
+	QRModifiedGramSchmidt
+)
+
+// QR-разложение матрицы размера m x n: A = QR. Разложение "экономное":
+// при k = min(m, n) матрица Q имеет размер m x k, а R - размер k x n.
+type QRDecomposition struct {
+	Q                 Matrix  // Матрица с ортонормированными столбцами
+	R                 Matrix  // Верхнетреугольная (трапециевидная) матрица
+	Residual          float64 // Невязка ||A - QR|| (норма Фробениуса)
+	OrthogonalityLoss float64 // Потеря ортогональности ||QᵀQ - E|| (норма Фробениуса)
+}
+
+// Возвращает QR-разложение матрицы, найденное отражениями Хаусхолдера.
+func (m Matrix) QR() QRDecomposition {
+	qr, _ := m.QRBy(QRHouseholder)
+	return qr
+}
+
+// Возвращает QR-разложение матрицы, найденное заданным способом. В
+// разложении Хаусхолдера диагональные элементы R могут быть
+// отрицательными, в процессе Грама-Шмидта они положительны.
+//
+// Возвращает ошибку, если способ неизвестен или для процесса Грама-Шмидта
+// столбцы матрицы линейно зависимы.
+func (m Matrix) QRBy(method QRMethod) (QRDecomposition, error) {
+	var q, r Matrix
+	var err error
+	switch method {
+	case QRHouseholder:
+		q, r = m.householderQR()
+	case QRGramSchmidt:
+		q, r, err = m.gramSchmidtQR(false)
+	case QRModifiedGramSchmidt:
+		q, r, err = m.gramSchmidtQR(true)
+	default:
+		return QRDecomposition{}, UnknownMethodError(byte(method))
+	}
+	if err != nil {
+		return QRDecomposition{}, err
+	}
+
+	// Невязка и потеря ортогональности
+	product, _ := q.MultiplyMatrix(r)
+	product.AddMatrix(m, true)
+	gram, _ := q.Transpose().MultiplyMatrix(q)
+	gram.AddMatrix(IdentityMatrix(q.columns), true)
+
+	return QRDecomposition{q, r, frobeniusNorm(product.rowSlices()), frobeniusNorm(gram.rowSlices())}, nil
+}
+
+// Возвращает ортонормированную систему векторов, полученную из заданной
+// модифицированным процессом Грама-Шмидта. Линейные оболочки первых k
+// векторов исходной и полученной систем совпадают.
+//
+// Возвращает ошибку, если векторы имеют разную длину или линейно зависимы.
+func GramSchmidt(vectors [][]float64) ([][]float64, error) {
+	rows, err := NewMatrix(vectors)
+	if err != nil {
+		return nil, err
+	}
+	// Векторы становятся столбцами матрицы
+	q, _, err := rows.Transpose().gramSchmidtQR(true)
+	if err != nil {
+		return nil, err
+	}
+	return q.Transpose().rowSlices(), nil
+}
+
+// Выполняет QR-разложение отражениями Хаусхолдера: столбцы матрицы по
+// очереди отражаются так, чтобы под диагональю остались нули.
+func (m Matrix) householderQR() (Matrix, Matrix) {
+	k := min(m.rows, m.columns)
+	r := m.clone()
+	q := IdentityMatrix(m.rows)
+
+	for j := 0; j < min(m.rows-1, m.columns); j++ {
+		norm := 0.0
+		for i := j; i < m.rows; i++ {
+			norm = math.Hypot(norm, r.row(i)[j])
+		}
+		if norm == 0 {
+			continue
+		}
+		alpha := -math.Copysign(norm, r.row(j)[j])
+		v := make([]float64, m.rows)
+		for i := j; i < m.rows; i++ {
+			v[i] = r.row(i)[j]
+		}
+		v[j] -= alpha
+		length := 0.0
+		for i := j; i < m.rows; i++ {
+			length = math.Hypot(length, v[i])
+		}
+		for i := j; i < m.rows; i++ {
+			v[i] /= length
+		}
+
+		// R = (E - 2vvᵀ) R, Q = Q (E - 2vvᵀ)
+		reflectRows(r.rowSlices(), v, j)
+		reflectColumns(q.rowSlices(), v, j)
+		r.row(j)[j] = alpha
+		for i := j + 1; i < m.rows; i++ {
+			r.row(i)[j] = 0
+		}
+	}
+
+	// Оставляем первые k столбцов Q и первые k строк R
+	thinQ := ZeroMatrix(m.rows, k)
+	for i := 0; i < m.rows; i++ {
+		copy(thinQ.row(i), q.row(i)[:k])
+	}
+	return thinQ, matrixFromRows(k, m.columns, r.rowSlices()[:k])
+}
+
+// Выполняет QR-разложение процессом Грама-Шмидта: из каждого столбца
+// вычитаются проекции на уже найденные столбцы Q, а остаток нормируется.
+// Если modified равен true, проекции вычисляются по изменённому столбцу.
+//
+// Возвращает ошибку, если столбцы матрицы линейно зависимы.
+func (m Matrix) gramSchmidtQR(modified bool) (Matrix, Matrix, error) {
+	q := ZeroMatrix(m.rows, m.columns)
+	r := ZeroMatrix(m.columns, m.columns)
+
+	for j := 0; j < m.columns; j++ {
+		v := make([]float64, m.rows)
+		original := 0.0
+		for i := 0; i < m.rows; i++ {
+			v[i] = m.row(i)[j]
+			original = math.Hypot(original, v[i])
+		}
+
+		for k := 0; k < j; k++ {
+			// rₖⱼ = qₖᵀaⱼ (классический) или qₖᵀv (модифицированный)
+			projection := 0.0
+			for i := 0; i < m.rows; i++ {
+				if modified {
+					projection += q.row(i)[k] * v[i]
+				} else {
+					projection += q.row(i)[k] * m.row(i)[j]
+				}
+			}
+			r.row(k)[j] = projection
+			for i := 0; i < m.rows; i++ {
+				v[i] -= projection * q.row(i)[k]
+			}
+		}
+
+		// Остаток, близкий к нулю, означает линейную зависимость
+		norm := 0.0
+		for i := 0; i < m.rows; i++ {
+			norm = math.Hypot(norm, v[i])
+		}
+		if norm <= Epsilon*original || norm == 0 {
+			return Matrix{}, Matrix{}, LinearlyDependentError(j + 1)
+		}
+		r.row(j)[j] = norm
+		for i := 0; i < m.rows; i++ {
+			q.row(i)[j] = v[i] / norm
+		}
+	}
+	return q, r, nil
+}
+
+// Возвращает норму Фробениуса: корень из суммы квадратов элементов.
+func frobeniusNorm(elements [][]float64) float64 {
+	norm := 0.0
+	for i := range elements {
+		for j := range elements[i] {
+			norm = math.Hypot(norm, elements[i][j])
+		}
+	}
+	return norm
+}
@@ -0,0 +1,155 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Возвращает матрицу Гильберта порядка n: aᵢⱼ = 1 / (i + j + 1).
+func hilbertMatrix(n int) Matrix {
+	hilbert := ZeroMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			hilbert.row(i)[j] = 1 / float64(i+j+1)
+		}
+	}
+	return hilbert
+}
+
+// QR-разложение всеми способами
+func TestQR(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		methods  []QRMethod
+	}{
+		{[][]float64{{3, 1}, {4, 2}}, []QRMethod{QRHouseholder, QRGramSchmidt, QRModifiedGramSchmidt}},
+		{[][]float64{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}}, []QRMethod{QRHouseholder, QRGramSchmidt, QRModifiedGramSchmidt}},
+		{[][]float64{{1, 1}, {1, 0}, {0, 1}, {1, 1}}, []QRMethod{QRHouseholder, QRGramSchmidt, QRModifiedGramSchmidt}},
+		{[][]float64{{1, 2, 3}, {4, 5, 6}}, []QRMethod{QRHouseholder}},
+		{[][]float64{{1, 2}, {2, 4}, {3, 6}}, []QRMethod{QRHouseholder}},
+	}
+
+	for _, tt := range tests {
+		for _, method := range tt.methods {
+			testname := fmt.Sprintf("%v method=%d", tt.elements, method)
+			t.Run(testname, func(t *testing.T) {
+				matrix, _ := NewMatrix(tt.elements)
+				qr, err := matrix.QRBy(method)
+				if err != nil {
+					t.Fatalf("got an error while calculating QR: %v", err)
+				}
+				k := min(matrix.rows, matrix.columns)
+				if qr.Q.rows != matrix.rows || qr.Q.columns != k || qr.R.rows != k || qr.R.columns != matrix.columns {
+					t.Fatalf("got Q %dx%d and R %dx%d", qr.Q.rows, qr.Q.columns, qr.R.rows, qr.R.columns)
+				}
+				for i := 0; i < qr.R.rows; i++ {
+					for j := 0; j < i; j++ {
+						if qr.R.row(i)[j] != 0 {
+							t.Errorf("got R[%d][%d] = %g, want 0", i, j, qr.R.row(i)[j])
+						}
+					}
+				}
+				if qr.Residual > 1e-12 {
+					t.Errorf("got residual %g, want less than 1e-12", qr.Residual)
+				}
+				if qr.OrthogonalityLoss > 1e-12 {
+					t.Errorf("got orthogonality loss %g, want less than 1e-12", qr.OrthogonalityLoss)
+				}
+			})
+		}
+	}
+}
+
+// Значения Q и R в процессе Грама-Шмидта
+func TestQRGramSchmidt(t *testing.T) {
+	matrix, _ := NewMatrix([][]float64{{3, 1}, {4, 2}})
+	wantQ := [][]float64{{0.6, -0.8}, {0.8, 0.6}}
+	wantR := [][]float64{{5, 2.2}, {0, 0.4}}
+
+	for _, method := range []QRMethod{QRGramSchmidt, QRModifiedGramSchmidt} {
+		testname := fmt.Sprintf("method=%d", method)
+		t.Run(testname, func(t *testing.T) {
+			qr, _ := matrix.QRBy(method)
+			if !approximatelyEqual(qr.Q.rowSlices(), wantQ, 1e-12) {
+				t.Errorf("got Q = %v, want %v", qr.Q.rowSlices(), wantQ)
+			}
+			if !approximatelyEqual(qr.R.rowSlices(), wantR, 1e-12) {
+				t.Errorf("got R = %v, want %v", qr.R.rowSlices(), wantR)
+			}
+		})
+	}
+
+	dependent, _ := NewMatrix([][]float64{{1, 2}, {2, 4}, {3, 6}})
+	if _, err := dependent.QRBy(QRGramSchmidt); err == nil || err.Error() != LinearlyDependentError(2).Error() {
+		t.Errorf("got %v, want %v", err, LinearlyDependentError(2))
+	}
+	if _, err := matrix.QRBy(3); err == nil || err.Error() != UnknownMethodError(3).Error() {
+		t.Errorf("got %v, want %v", err, UnknownMethodError(3))
+	}
+}
+
+// Потеря ортогональности на плохо обусловленной матрице Гильберта
+func TestQROrthogonalityLoss(t *testing.T) {
+	hilbert := hilbertMatrix(8)
+	classical, err := hilbert.QRBy(QRGramSchmidt)
+	if err != nil {
+		t.Fatalf("got an error while calculating QR: %v", err)
+	}
+	modified, err := hilbert.QRBy(QRModifiedGramSchmidt)
+	if err != nil {
+		t.Fatalf("got an error while calculating QR: %v", err)
+	}
+	householder := hilbert.QR()
+
+	for _, qr := range []QRDecomposition{classical, modified, householder} {
+		if qr.Residual > 1e-12 {
+			t.Errorf("got residual %g, want less than 1e-12", qr.Residual)
+		}
+	}
+	if !(classical.OrthogonalityLoss > modified.OrthogonalityLoss && modified.OrthogonalityLoss > householder.OrthogonalityLoss) {
+		t.Errorf("got orthogonality loss %g (classical), %g (modified), %g (Householder), want decreasing",
+			classical.OrthogonalityLoss, modified.OrthogonalityLoss, householder.OrthogonalityLoss)
+	}
+	if classical.OrthogonalityLoss < 0.1 {
+		t.Errorf("got classical orthogonality loss %g, want noticeable loss", classical.OrthogonalityLoss)
+	}
+	if householder.OrthogonalityLoss > 1e-12 {
+		t.Errorf("got Householder orthogonality loss %g, want less than 1e-12", householder.OrthogonalityLoss)
+	}
+}
+
+// Ортогонализация системы векторов
+func TestGramSchmidt(t *testing.T) {
+	tests := []struct {
+		vectors [][]float64
+		want    [][]float64
+		wantErr error
+	}{
+		{
+			[][]float64{{1, 1, 0}, {1, 0, 1}},
+			[][]float64{{1 / math.Sqrt2, 1 / math.Sqrt2, 0}, {1 / math.Sqrt(6), -1 / math.Sqrt(6), 2 / math.Sqrt(6)}},
+			nil,
+		},
+		{[][]float64{{0, 3}}, [][]float64{{0, 1}}, nil},
+		{[][]float64{{1, 2}, {2, 4}}, nil, LinearlyDependentError(2)},
+		{[][]float64{{1, 0}, {0, 1}, {1, 1}}, nil, LinearlyDependentError(3)},
+		{[][]float64{{1, 2}, {1}}, nil, InvalidMatrixError(2)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.vectors)
+		t.Run(testname, func(t *testing.T) {
+			got, err := GramSchmidt(tt.vectors)
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while orthonormalizing: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err == nil && !approximatelyEqual(got, tt.want, 1e-12) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
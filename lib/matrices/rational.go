@@ -0,0 +1,228 @@
+package matrices
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Матрица рациональных чисел. В отличие от Matrix, все вычисления выполняются
+// точно, без ошибок округления.
+type RationalMatrix struct {
+	rows     int          // Количество строк
+	columns  int          // Количество столбцов
+	elements [][]*big.Rat // Элементы матрицы
+}
+
+// Элементарное преобразование строк рациональной матрицы.
+type RationalRowOperation = FieldRowOperation[*big.Rat]
+
+// Записывает рациональное число, заключая отрицательные числа в скобки.
+func formatRational(number *big.Rat) string {
+	if number.Sign() < 0 {
+		return fmt.Sprintf("(%s)", number.RatString())
+	}
+	return number.RatString()
+}
+
+// Возвращает нулевую рациональную матрицу.
+func ZeroRationalMatrix(rows int, columns int) RationalMatrix {
+	return RationalMatrix{rows, columns, zeroElements(RationalField{}, rows, columns)}
+}
+
+// Возвращает единичную рациональную матрицу порядка n.
+func IdentityRationalMatrix(n int) RationalMatrix {
+	identity := ZeroRationalMatrix(n, n)
+	for i := 0; i < n; i++ {
+		identity.elements[i][i].SetInt64(1)
+	}
+	return identity
+}
+
+// Возвращает матрицу рациональных чисел.
+//
+// Возвращает ошибку, если в матрице не одинаковое количество столбцов или
+// есть пустые (nil) элементы.
+func NewRationalMatrix(elements [][]*big.Rat) (RationalMatrix, error) {
+	// Проверка правильности заданной матрицы
+	rows := len(elements)
+	columns := 0
+	for i := 0; i < rows; i++ {
+		if i == 0 {
+			columns = len(elements[i])
+		} else if len(elements[i]) != columns {
+			return RationalMatrix{}, InvalidMatrixError(i + 1)
+		}
+		for j := range elements[i] {
+			if elements[i][j] == nil {
+				return RationalMatrix{}, InvalidMatrixError(i + 1)
+			}
+		}
+	}
+	return RationalMatrix{rows, columns, elements}, nil
+}
+
+// Возвращает рациональную матрицу из целых чисел.
+//
+// Возвращает ошибку, если в матрице не одинаковое количество столбцов.
+func NewIntegerRationalMatrix(elements [][]int64) (RationalMatrix, error) {
+	rationals := make([][]*big.Rat, len(elements))
+	for i := range elements {
+		rationals[i] = make([]*big.Rat, len(elements[i]))
+		for j := range elements[i] {
+			rationals[i][j] = big.NewRat(elements[i][j], 1)
+		}
+	}
+	return NewRationalMatrix(rationals)
+}
+
+// Возвращает рациональную матрицу, равную заданной матрице действительных
+// чисел. Преобразование точное: например, 0.1 станет дробью
+// 3602879701896397/36028797018963968, так как именно это число хранится в
+// float64.
+//
+// Возвращает ошибку, если в матрице есть бесконечности или NaN.
+func NewRationalMatrixFromMatrix(m Matrix) (RationalMatrix, error) {
+	result := ZeroRationalMatrix(m.rows, m.columns)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.columns; j++ {
+			if result.elements[i][j].SetFloat64(m.row(i)[j]) == nil {
+				return RationalMatrix{}, NotFiniteElementError(i+1, j+1)
+			}
+		}
+	}
+	return result, nil
+}
+
+// Возвращает матрицу действительных чисел, ближайших к элементам
+// рациональной матрицы.
+func (r RationalMatrix) ToMatrix() Matrix {
+	result := ZeroMatrix(r.rows, r.columns)
+	for i := 0; i < r.rows; i++ {
+		for j := 0; j < r.columns; j++ {
+			result.row(i)[j], _ = r.elements[i][j].Float64()
+		}
+	}
+	return result
+}
+
+// Возвращает количество строк матрицы.
+func (r RationalMatrix) Rows() int {
+	return r.rows
+}
+
+// Возвращает количество столбцов матрицы.
+func (r RationalMatrix) Columns() int {
+	return r.columns
+}
+
+// Возвращает копию элемента матрицы в заданной строке и столбце (нумерация
+// с нуля).
+func (r RationalMatrix) At(row int, column int) *big.Rat {
+	return new(big.Rat).Set(r.elements[row][column])
+}
+
+// Возвращает копию матрицы. Операции поля не изменяют элементы, поэтому
+// сами числа не копируются.
+func (r RationalMatrix) clone() RationalMatrix {
+	return RationalMatrix{r.rows, r.columns, cloneElements(r.elements)}
+}
+
+// Умножает каждый элемент матрицы на заданное число.
+func (r *RationalMatrix) MultiplyByNumber(number *big.Rat) {
+	scaleElements(RationalField{}, r.elements, number)
+}
+
+// Делит каждый элемент матрицы на заданное число.
+//
+// Возвращает ошибку, если число равно нулю.
+func (r *RationalMatrix) DivideByNumber(number *big.Rat) error {
+	if number.Sign() == 0 {
+		return DivisionByZeroError()
+	}
+	scaleElements(RationalField{}, r.elements, new(big.Rat).Inv(number))
+	return nil
+}
+
+// Возвращает транспонированную матрицу.
+func (r RationalMatrix) Transpose() RationalMatrix {
+	return RationalMatrix{r.columns, r.rows, transposeElements(r.elements, r.rows, r.columns)}
+}
+
+// Прибавляет к каждому элементу матрицы элементы другой матрицы.
+//
+// Если аргумент negative равен true, то будет произведено вычитание матриц.
+func (r *RationalMatrix) AddMatrix(other RationalMatrix, negative bool) error {
+	// У матриц должны быть равно количество строк и столбцов
+	if r.rows != other.rows || r.columns != other.columns {
+		return NotSameSizeError(r.rows, r.columns, other.rows, other.columns)
+	}
+
+	addElements(RationalField{}, r.elements, other.elements, negative)
+
+	return nil
+}
+
+// Возвращает матрицу, являющуюся результатом умножения текущей матрицы на
+// другую заданную.
+//
+// Возвращает ошибку, если матрицы нельзя перемножить.
+func (r RationalMatrix) MultiplyMatrix(other RationalMatrix) (RationalMatrix, error) {
+	// Число столбцов первой матрицы должно совпадать с числом строк второй
+	if r.columns != other.rows {
+		return RationalMatrix{}, UnableToMultiplyError(r.columns, other.rows)
+	}
+
+	elements := multiplyElements(RationalField{}, r.elements, other.elements, r.rows, r.columns, other.columns)
+	return RationalMatrix{r.rows, other.columns, elements}, nil
+}
+
+// Возвращает определитель квадратной матрицы, вычисленный точно приведением
+// к верхнетреугольному виду.
+//
+// Возвращает ошибку, если матрица не квадратная.
+func (r RationalMatrix) Determinator() (*big.Rat, error) {
+	// Матрица должна быть квадратной
+	if r.rows != r.columns {
+		return nil, NotSquareMatrixError()
+	}
+
+	return determinatorElements(RationalField{}, r.elements, r.rows), nil
+}
+
+// Возвращает обратную матрицу, найденную методом Гаусса-Жордана.
+//
+// Возвращает ошибку, если матрица не квадратная или вырожденная.
+func (r RationalMatrix) Inverse() (RationalMatrix, error) {
+	// Матрица должна быть квадратной
+	if r.rows != r.columns {
+		return RationalMatrix{}, NotSquareMatrixError()
+	}
+
+	elements, err := inverseElements(RationalField{}, r.elements, r.rows)
+	if err != nil {
+		return RationalMatrix{}, err
+	}
+	return RationalMatrix{r.rows, r.columns, elements}, nil
+}
+
+// Возвращает ступенчатый вид матрицы и последовательность выполненных
+// элементарных преобразований строк. Так как вычисления точные, ведущим
+// выбирается первый ненулевой элемент столбца.
+func (r RationalMatrix) RowEchelon() (RationalMatrix, []RationalRowOperation) {
+	result := r.clone()
+	_, operations := rowReduceElements(RationalField{}, result.elements, result.columns, false)
+	return result, operations
+}
+
+// Возвращает упрощённый ступенчатый вид матрицы и последовательность
+// выполненных элементарных преобразований строк.
+func (r RationalMatrix) RREF() (RationalMatrix, []RationalRowOperation) {
+	result := r.clone()
+	_, operations := rowReduceElements(RationalField{}, result.elements, result.columns, true)
+	return result, operations
+}
+
+// Применяет к матрице элементарное преобразование строк.
+func (r *RationalMatrix) ApplyRowOperation(operation RationalRowOperation) {
+	applyRowOperationElements(RationalField{}, r.elements, operation)
+}
@@ -0,0 +1,198 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// Записывает элементы рациональной матрицы в виде дробей
+func ratStrings(r RationalMatrix) [][]string {
+	result := make([][]string, r.rows)
+	for i := range result {
+		result[i] = make([]string, r.columns)
+		for j := range result[i] {
+			result[i][j] = r.elements[i][j].RatString()
+		}
+	}
+	return result
+}
+
+// Создание рациональной матрицы
+func TestNewRationalMatrix(t *testing.T) {
+	tests := []struct {
+		elements [][]*big.Rat
+		want     error
+	}{
+		{[][]*big.Rat{{big.NewRat(1, 2), big.NewRat(1, 3)}, {big.NewRat(1, 4)}}, InvalidMatrixError(2)},
+		{[][]*big.Rat{{big.NewRat(1, 2), nil}}, InvalidMatrixError(1)},
+		{[][]*big.Rat{{big.NewRat(1, 2), big.NewRat(1, 3)}}, nil},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			_, err := NewRationalMatrix(tt.elements)
+			if err == nil && tt.want != nil {
+				t.Fatalf("no error %q", tt.want)
+			}
+			if err != nil && err.Error() != tt.want.Error() {
+				t.Errorf("got %q, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// Преобразование между рациональной матрицей и матрицей действительных чисел
+func TestRationalMatrixConversion(t *testing.T) {
+	matrix, _ := NewMatrix([][]float64{{0.5, -3}, {0.1, 0}})
+	rational, err := NewRationalMatrixFromMatrix(matrix)
+	if err != nil {
+		t.Fatalf("got an error while converting Matrix: %v", err)
+	}
+	want := "[[1/2 -3] [3602879701896397/36028797018963968 0]]"
+	if got := fmt.Sprintf("%v", ratStrings(rational)); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := rational.ToMatrix(); fmt.Sprintf("%v", got.rowSlices()) != fmt.Sprintf("%v", matrix.rowSlices()) {
+		t.Errorf("got %v, want %v", got.rowSlices(), matrix.rowSlices())
+	}
+
+	infinite, _ := NewMatrix([][]float64{{1, math.Inf(1)}})
+	if _, err := NewRationalMatrixFromMatrix(infinite); err == nil || err.Error() != NotFiniteElementError(1, 2).Error() {
+		t.Errorf("got %v, want %v", err, NotFiniteElementError(1, 2))
+	}
+}
+
+// Действия с числами, транспонирование, сложение и умножение
+func TestRationalMatrixOperations(t *testing.T) {
+	matrix1, _ := NewIntegerRationalMatrix([][]int64{{1, 2}, {3, 4}})
+	matrix2, _ := NewIntegerRationalMatrix([][]int64{{0, 1}, {1, 0}})
+
+	matrix1.DivideByNumber(big.NewRat(3, 1))
+	if got := fmt.Sprintf("%v", ratStrings(matrix1)); got != "[[1/3 2/3] [1 4/3]]" {
+		t.Errorf("divide: got %v", got)
+	}
+	if err := matrix1.DivideByNumber(new(big.Rat)); err == nil || err.Error() != DivisionByZeroError().Error() {
+		t.Errorf("got %v, want %v", err, DivisionByZeroError())
+	}
+	matrix1.MultiplyByNumber(big.NewRat(3, 2))
+	if got := fmt.Sprintf("%v", ratStrings(matrix1)); got != "[[1/2 1] [3/2 2]]" {
+		t.Errorf("multiply: got %v", got)
+	}
+	if got := fmt.Sprintf("%v", ratStrings(matrix1.Transpose())); got != "[[1/2 3/2] [1 2]]" {
+		t.Errorf("transpose: got %v", got)
+	}
+
+	product, err := matrix1.MultiplyMatrix(matrix2)
+	if err != nil {
+		t.Fatalf("got an error while multiplying: %v", err)
+	}
+	if got := fmt.Sprintf("%v", ratStrings(product)); got != "[[1 1/2] [2 3/2]]" {
+		t.Errorf("product: got %v", got)
+	}
+
+	if err := matrix1.AddMatrix(matrix2, true); err != nil {
+		t.Fatalf("got an error while subtracting: %v", err)
+	}
+	if got := fmt.Sprintf("%v", ratStrings(matrix1)); got != "[[1/2 0] [1/2 2]]" {
+		t.Errorf("subtract: got %v", got)
+	}
+
+	row, _ := NewIntegerRationalMatrix([][]int64{{1, 2, 3}})
+	if err := matrix1.AddMatrix(row, false); err == nil || err.Error() != NotSameSizeError(2, 2, 1, 3).Error() {
+		t.Errorf("got %v, want %v", err, NotSameSizeError(2, 2, 1, 3))
+	}
+	if _, err := matrix1.MultiplyMatrix(row); err == nil {
+		t.Errorf("no error %q", UnableToMultiplyError(2, 1))
+	}
+}
+
+// Точное вычисление определителя
+func TestRationalMatrixDeterminator(t *testing.T) {
+	tests := []struct {
+		elements [][]int64
+		want     string
+		wantErr  error
+	}{
+		{[][]int64{{1, 2, 3}, {4, 5, 6}}, "", NotSquareMatrixError()},
+		{[][]int64{}, "1", nil},
+		{[][]int64{{11, -3}, {-15, -2}}, "-67", nil},
+		{[][]int64{{1, -2, 3}, {4, 0, 6}, {-7, 8, 9}}, "204", nil},
+		{[][]int64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, "0", nil},
+		{[][]int64{{0, 1, 2, 3}, {1, 0, 1, 2}, {2, 1, 0, 1}, {3, 2, 1, 0}}, "-12", nil},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewIntegerRationalMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing RationalMatrix: %v", err)
+			}
+			got, err := matrix.Determinator()
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while calculating Determinator: %v", err)
+			}
+			if err == nil && tt.wantErr != nil {
+				t.Fatalf("no error %q", tt.wantErr)
+			}
+			if err == nil && got.RatString() != tt.want {
+				t.Errorf("got %s, want %s", got.RatString(), tt.want)
+			}
+		})
+	}
+}
+
+// Точное нахождение обратной матрицы
+func TestRationalMatrixInverse(t *testing.T) {
+	tests := []struct {
+		elements [][]int64
+		want     string
+		wantErr  error
+	}{
+		{[][]int64{{1, 2, 3}, {4, 5, 6}}, "", NotSquareMatrixError()},
+		{[][]int64{{1, 2}, {2, 4}}, "", SingularMatrixError(2)},
+		{[][]int64{{4, 7}, {2, 6}}, "[[3/5 -7/10] [-1/5 2/5]]", nil},
+		{[][]int64{{2, 5, 4}, {1, 3, 2}, {2, 10, 9}}, "[[7/5 -1 -2/5] [-1 2 0] [4/5 -2 1/5]]", nil},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewIntegerRationalMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing RationalMatrix: %v", err)
+			}
+			got, err := matrix.Inverse()
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while inverting: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err == nil && fmt.Sprintf("%v", ratStrings(got)) != tt.want {
+				t.Errorf("got %v, want %v", ratStrings(got), tt.want)
+			}
+		})
+	}
+}
+
+// Точный упрощённый ступенчатый вид и журнал преобразований
+func TestRationalMatrixRREF(t *testing.T) {
+	matrix, _ := NewIntegerRationalMatrix([][]int64{{0, 3, 6}, {2, 4, 2}, {1, 2, 1}})
+	got, operations := matrix.RREF()
+	if want := "[[1 0 -3] [0 1 2] [0 0 0]]"; fmt.Sprintf("%v", ratStrings(got)) != want {
+		t.Errorf("got %v, want %v", ratStrings(got), want)
+	}
+	wantOperations := "[R1 <-> R2 R1 * 1/2 R3 + (-1) * R1 R2 * 1/3 R1 + (-2) * R2]"
+	if fmt.Sprintf("%v", operations) != wantOperations {
+		t.Errorf("got %v, want %v", operations, wantOperations)
+	}
+
+	echelon, _ := matrix.RowEchelon()
+	if want := "[[2 4 2] [0 3 6] [0 0 0]]"; fmt.Sprintf("%v", ratStrings(echelon)) != want {
+		t.Errorf("got %v, want %v", ratStrings(echelon), want)
+	}
+}
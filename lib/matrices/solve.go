@@ -0,0 +1,189 @@
+package matrices
+
+import "math"
+
+// Способ решения системы линейных уравнений.
+type SolveMethod byte
+
+const (
+	// Метод Гаусса: приведение расширенной матрицы к ступенчатому виду.
+	SolveGauss SolveMethod = iota
+	// Метод Крамера: xᵢ = det Aᵢ / det A.
+	SolveCramer
+	// Матричный метод: x = A⁻¹b.
+	SolveInverse
+)
+
+// Количество решений системы линейных уравнений.
+type SolutionKind byte
+
+const (
+	// Система несовместна.
+	NoSolution SolutionKind = iota
+	// Система имеет единственное решение.
+	UniqueSolution
+	// Система имеет бесконечно много решений.
+	InfiniteSolutions
+)
+
+// Решение системы линейных уравнений Ax = b.
+//
+// Общее решение системы записывается как x = Particular + Σ cᵢ * Basis[i],
+// где cᵢ - произвольные числа.
+//
+// Для единственного решения вычисляется число обусловленности матрицы
+// системы. Если оно слишком велико, ошибки округления могут сильно исказить
+// решение, и Warning содержит IllConditionedError.
+type Solution struct {
+	Kind       SolutionKind // Количество решений
+	Particular []float64    // Частное решение (пустое, если система несовместна)
+	Basis      [][]float64  // Фундаментальная система решений однородной системы
+	Condition  float64      // Число обусловленности (только для единственного решения)
+	Warning    error        // Предупреждение о неточности решения (nil, если его нет)
+}
+
+// Решает систему линейных уравнений Ax = b методом Гаусса.
+//
+// Возвращает ошибку, если длина b не равна количеству строк A.
+func Solve(a Matrix, b []float64) (Solution, error) {
+	return SolveBy(a, b, SolveGauss)
+}
+
+// Решает систему линейных уравнений Ax = b заданным способом.
+//
+// Количество решений всегда определяется методом Гаусса по рангам матрицы
+// системы и расширенной матрицы. Методы Крамера и матричный применяются,
+// только если решение единственно.
+//
+// Возвращает ошибку, если длина b не равна количеству строк A, способ
+// неизвестен или для метода Крамера и матричного метода матрица не
+// квадратная.
+func SolveBy(a Matrix, b []float64, method SolveMethod) (Solution, error) {
+	if len(b) != a.rows {
+		return Solution{}, NotSameSizeError(a.rows, 1, len(b), 1)
+	}
+	if method > SolveInverse {
+		return Solution{}, UnknownMethodError(byte(method))
+	}
+
+	solution := solveGauss(a, b)
+	if solution.Kind == UniqueSolution {
+		solution.Condition = a.solveCondition()
+		solution.Warning = conditionWarning(solution.Condition)
+	}
+	if solution.Kind != UniqueSolution || method == SolveGauss {
+		return solution, nil
+	}
+
+	// Методы Крамера и матричный требуют квадратную матрицу
+	if a.rows != a.columns {
+		return Solution{}, NotSquareMatrixError()
+	}
+
+	var err error
+	switch method {
+	case SolveCramer:
+		solution.Particular, err = solveCramer(a, b)
+	case SolveInverse:
+		solution.Particular, err = solveInverse(a, b)
+	}
+	if err != nil {
+		return Solution{}, err
+	}
+	return solution, nil
+}
+
+// Решает систему методом Гаусса и определяет количество решений.
+func solveGauss(a Matrix, b []float64) Solution {
+	kind, particular, basis := solveElements(RealField{}, a.rowSlices(), b, a.columns)
+	return Solution{kind, particular, basis, 0, nil}
+}
+
+// Возвращает число обусловленности матрицы системы с единственным
+// решением: оценку в 1-норме для квадратной матрицы и спектральное число
+// обусловленности для прямоугольной.
+func (m Matrix) solveCondition() float64 {
+	if m.rows == m.columns {
+		condition, _ := m.ConditionEstimate()
+		return condition
+	}
+	condition, err := m.ConditionNumber()
+	if err != nil {
+		return math.Inf(1)
+	}
+	return condition
+}
+
+// Решает систему с матрицей, заданной элементами, методом Гаусса над полем.
+// Возвращает количество решений, частное решение и фундаментальную систему
+// решений однородной системы.
+func solveElements[T any](field Field[T], a [][]T, b []T, columns int) (SolutionKind, []T, [][]T) {
+	// Составляем расширенную матрицу [A|b]
+	augmented := zeroElements(field, len(a), columns+1)
+	for i := range a {
+		copy(augmented[i], a[i])
+		augmented[i][columns] = b[i]
+	}
+
+	pivots, _ := rowReduceElements(field, augmented, columns+1, true)
+
+	// Ведущий элемент в столбце свободных членов означает уравнение 0 = 1
+	if len(pivots) > 0 && pivots[len(pivots)-1] == columns {
+		return NoSolution, []T{}, [][]T{}
+	}
+
+	// Частное решение: свободные переменные равны нулю
+	particular := make([]T, columns)
+	for i := range particular {
+		particular[i] = field.Zero()
+	}
+	for row, column := range pivots {
+		particular[column] = augmented[row][columns]
+	}
+
+	// Каждой свободной переменной соответствует вектор базиса
+	basis := nullSpaceElements(field, augmented, pivots, columns)
+	if len(basis) == 0 {
+		return UniqueSolution, particular, basis
+	}
+	return InfiniteSolutions, particular, basis
+}
+
+// Решает систему с квадратной невырожденной матрицей методом Крамера.
+func solveCramer(a Matrix, b []float64) ([]float64, error) {
+	determinator, err := a.Determinator()
+	if err != nil {
+		return nil, err
+	}
+	if determinator == 0 {
+		return nil, ZeroDeterminatorError()
+	}
+
+	x := make([]float64, a.columns)
+	for i := 0; i < a.columns; i++ {
+		// Заменяем i-й столбец столбцом свободных членов
+		replaced := a.clone()
+		for j := 0; j < a.rows; j++ {
+			replaced.row(j)[i] = b[j]
+		}
+		numerator, _ := replaced.Determinator()
+		x[i] = numerator / determinator
+	}
+	return x, nil
+}
+
+// Решает систему с квадратной невырожденной матрицей матричным методом.
+func solveInverse(a Matrix, b []float64) ([]float64, error) {
+	inverse, err := a.Inverse()
+	if err != nil {
+		return nil, err
+	}
+
+	x := make([]float64, a.columns)
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < a.columns; j++ {
+			x[i] += inverse.row(i)[j] * b[j]
+		}
+	}
+	return x, nil
+}
@@ -0,0 +1,119 @@
+package matrices
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Решение систем с определением количества решений
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		elements       [][]float64
+		b              []float64
+		wantKind       SolutionKind
+		wantParticular []float64
+		wantBasis      [][]float64
+	}{
+		// Единственное решение
+		{[][]float64{{2, 5, 4}, {1, 3, 2}, {2, 10, 9}}, []float64{11, 6, 21}, UniqueSolution, []float64{1, 1, 1}, [][]float64{}},
+		{[][]float64{{1, 1}, {1, -1}, {2, 0}}, []float64{3, 1, 4}, UniqueSolution, []float64{2, 1}, [][]float64{}},
+		// Бесконечно много решений
+		{[][]float64{{1, 2, 3}, {2, 4, 6}}, []float64{6, 12}, InfiniteSolutions, []float64{6, 0, 0}, [][]float64{{-2, 1, 0}, {-3, 0, 1}}},
+		{[][]float64{{1, 1, 1}, {0, 1, 2}}, []float64{3, 3}, InfiniteSolutions, []float64{0, 3, 0}, [][]float64{{1, -2, 1}}},
+		// Нет решений
+		{[][]float64{{1, 2}, {2, 4}}, []float64{1, 3}, NoSolution, []float64{}, [][]float64{}},
+		{[][]float64{{1, 1}, {1, -1}, {2, 0}}, []float64{3, 1, 5}, NoSolution, []float64{}, [][]float64{}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v|%v", tt.elements, tt.b)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing Matrix: %v", err)
+			}
+			got, err := Solve(matrix, tt.b)
+			if err != nil {
+				t.Fatalf("got an error while solving: %v", err)
+			}
+			if got.Kind != tt.wantKind {
+				t.Fatalf("got kind %d, want %d", got.Kind, tt.wantKind)
+			}
+			if !approximatelyEqual([][]float64{got.Particular}, [][]float64{tt.wantParticular}, 1e-9) {
+				t.Errorf("got particular %v, want %v", got.Particular, tt.wantParticular)
+			}
+			if !approximatelyEqual(got.Basis, tt.wantBasis, 1e-9) {
+				t.Errorf("got basis %v, want %v", got.Basis, tt.wantBasis)
+			}
+		})
+	}
+}
+
+// Решение систем методом Крамера и матричным методом
+func TestSolveBy(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		b        []float64
+		want     []float64
+	}{
+		{[][]float64{{2, 5, 4}, {1, 3, 2}, {2, 10, 9}}, []float64{11, 6, 21}, []float64{1, 1, 1}},
+		{[][]float64{{1, -2, 3}, {4, 0, 6}, {-7, 8, 9}}, []float64{2, 10, 10}, []float64{1, 1, 1}},
+		{[][]float64{{0, 1, 2, 3}, {1, 0, 1, 2}, {2, 1, 0, 1}, {3, 2, 1, 0}}, []float64{6, 4, 4, 6}, []float64{1, 1, 1, 1}},
+	}
+
+	methods := []SolveMethod{SolveGauss, SolveCramer, SolveInverse}
+
+	for _, tt := range tests {
+		for _, method := range methods {
+			testname := fmt.Sprintf("%d:%v|%v", method, tt.elements, tt.b)
+			t.Run(testname, func(t *testing.T) {
+				matrix, err := NewMatrix(tt.elements)
+				if err != nil {
+					t.Fatalf("got an error while initializing Matrix: %v", err)
+				}
+				got, err := SolveBy(matrix, tt.b, method)
+				if err != nil {
+					t.Fatalf("got an error while solving: %v", err)
+				}
+				if got.Kind != UniqueSolution {
+					t.Fatalf("got kind %d, want %d", got.Kind, UniqueSolution)
+				}
+				if !approximatelyEqual([][]float64{got.Particular}, [][]float64{tt.want}, 1e-9) {
+					t.Errorf("got %v, want %v", got.Particular, tt.want)
+				}
+			})
+		}
+	}
+}
+
+// Ошибки при решении систем
+func TestSolveByErrors(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		b        []float64
+		method   SolveMethod
+		want     error
+	}{
+		{[][]float64{{1, 2}, {3, 4}}, []float64{1}, SolveGauss, NotSameSizeError(2, 1, 1, 1)},
+		{[][]float64{{1, 2}, {3, 4}}, []float64{1, 2}, 42, UnknownMethodError(42)},
+		{[][]float64{{1, 1}, {1, -1}, {2, 0}}, []float64{3, 1, 4}, SolveCramer, NotSquareMatrixError()},
+		{[][]float64{{1, 1}, {1, -1}, {2, 0}}, []float64{3, 1, 4}, SolveInverse, NotSquareMatrixError()},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%d:%v|%v", tt.method, tt.elements, tt.b)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing Matrix: %v", err)
+			}
+			_, err = SolveBy(matrix, tt.b, tt.method)
+			if err == nil {
+				t.Fatalf("no error %q", tt.want)
+			}
+			if err.Error() != tt.want.Error() {
+				t.Errorf("got %q, want %q", err, tt.want)
+			}
+		})
+	}
+}
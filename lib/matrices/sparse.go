@@ -0,0 +1,358 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Ненулевой элемент разреженной матрицы.
+type SparseEntry struct {
+	Row    int     // Номер строки (нумерация с нуля)
+	Column int     // Номер столбца (нумерация с нуля)
+	Value  float64 // Значение элемента
+}
+
+// Разреженная матрица в координатном формате (COO): список ненулевых
+// элементов в произвольном порядке. Формат удобен для построения матрицы,
+// а для вычислений её следует преобразовать в SparseMatrix.
+type COOMatrix struct {
+	rows    int           // Количество строк
+	columns int           // Количество столбцов
+	entries []SparseEntry // Элементы матрицы
+}
+
+// Разреженная матрица в сжатом строчном формате (CSR). Хранятся только
+// ненулевые элементы: значения и номера столбцов элементов i-й строки
+// находятся в срезах values и columnIndices с позиции rowPointers[i] до
+// rowPointers[i+1]. Внутри строки элементы упорядочены по столбцам.
+type SparseMatrix struct {
+	rows          int       // Количество строк
+	columns       int       // Количество столбцов
+	rowPointers   []int     // Начало каждой строки (длина rows+1)
+	columnIndices []int     // Номера столбцов ненулевых элементов
+	values        []float64 // Значения ненулевых элементов
+}
+
+// Возвращает разреженную матрицу в координатном формате.
+//
+// Возвращает ошибку, если размеры матрицы отрицательны или какой-либо
+// элемент находится за пределами матрицы.
+func NewCOOMatrix(rows int, columns int, entries []SparseEntry) (COOMatrix, error) {
+	if rows < 0 || columns < 0 {
+		return COOMatrix{}, InvalidSizeError(rows, columns)
+	}
+	coo := COOMatrix{rows, columns, make([]SparseEntry, 0, len(entries))}
+	for _, entry := range entries {
+		if err := coo.Append(entry.Row, entry.Column, entry.Value); err != nil {
+			return COOMatrix{}, err
+		}
+	}
+	return coo, nil
+}
+
+// Добавляет элемент в матрицу. Если элемент с такими же номерами строки и
+// столбца уже есть, значения при преобразовании в SparseMatrix складываются.
+//
+// Возвращает ошибку, если элемент находится за пределами матрицы.
+func (c *COOMatrix) Append(row int, column int, value float64) error {
+	if row < 0 || row >= c.rows || column < 0 || column >= c.columns {
+		return IndexOutOfRangeError(row+1, column+1)
+	}
+	c.entries = append(c.entries, SparseEntry{row, column, value})
+	return nil
+}
+
+// Возвращает количество строк матрицы.
+func (c COOMatrix) Rows() int {
+	return c.rows
+}
+
+// Возвращает количество столбцов матрицы.
+func (c COOMatrix) Columns() int {
+	return c.columns
+}
+
+// Возвращает копию списка элементов матрицы.
+func (c COOMatrix) Entries() []SparseEntry {
+	return append([]SparseEntry{}, c.entries...)
+}
+
+// Возвращает матрицу в сжатом строчном формате. Повторяющиеся элементы
+// складываются, нулевые значения отбрасываются.
+func (c COOMatrix) ToCSR() SparseMatrix {
+	entries := c.Entries()
+	slices.SortStableFunc(entries, func(a SparseEntry, b SparseEntry) int {
+		if a.Row != b.Row {
+			return a.Row - b.Row
+		}
+		return a.Column - b.Column
+	})
+
+	// Складываем элементы с одинаковыми номерами
+	merged := entries[:0]
+	for _, entry := range entries {
+		last := len(merged) - 1
+		if last >= 0 && merged[last].Row == entry.Row && merged[last].Column == entry.Column {
+			merged[last].Value += entry.Value
+		} else {
+			merged = append(merged, entry)
+		}
+	}
+
+	result := SparseMatrix{c.rows, c.columns, make([]int, c.rows+1), []int{}, []float64{}}
+	for _, entry := range merged {
+		if entry.Value == 0 {
+			continue
+		}
+		result.columnIndices = append(result.columnIndices, entry.Column)
+		result.values = append(result.values, entry.Value)
+		result.rowPointers[entry.Row+1]++
+	}
+	for i := 0; i < c.rows; i++ {
+		result.rowPointers[i+1] += result.rowPointers[i]
+	}
+	return result
+}
+
+// Возвращает плотную матрицу действительных чисел. Повторяющиеся элементы
+// складываются.
+func (c COOMatrix) ToMatrix() Matrix {
+	result := ZeroMatrix(c.rows, c.columns)
+	for _, entry := range c.entries {
+		result.row(entry.Row)[entry.Column] += entry.Value
+	}
+	return result
+}
+
+// Возвращает разреженную матрицу, содержащую ненулевые элементы заданной
+// плотной матрицы.
+func NewSparseMatrixFromMatrix(m Matrix) SparseMatrix {
+	result := SparseMatrix{m.rows, m.columns, make([]int, m.rows+1), []int{}, []float64{}}
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.columns; j++ {
+			if m.row(i)[j] != 0 {
+				result.columnIndices = append(result.columnIndices, j)
+				result.values = append(result.values, m.row(i)[j])
+			}
+		}
+		result.rowPointers[i+1] = len(result.values)
+	}
+	return result
+}
+
+// Возвращает разреженную единичную матрицу порядка n.
+func IdentitySparseMatrix(n int) SparseMatrix {
+	result := SparseMatrix{n, n, make([]int, n+1), make([]int, n), make([]float64, n)}
+	for i := 0; i < n; i++ {
+		result.rowPointers[i+1] = i + 1
+		result.columnIndices[i] = i
+		result.values[i] = 1
+	}
+	return result
+}
+
+// Возвращает плотную матрицу действительных чисел.
+func (s SparseMatrix) ToMatrix() Matrix {
+	result := ZeroMatrix(s.rows, s.columns)
+	for i := 0; i < s.rows; i++ {
+		for k := s.rowPointers[i]; k < s.rowPointers[i+1]; k++ {
+			result.row(i)[s.columnIndices[k]] = s.values[k]
+		}
+	}
+	return result
+}
+
+// Возвращает матрицу в координатном формате.
+func (s SparseMatrix) ToCOO() COOMatrix {
+	entries := make([]SparseEntry, 0, len(s.values))
+	for i := 0; i < s.rows; i++ {
+		for k := s.rowPointers[i]; k < s.rowPointers[i+1]; k++ {
+			entries = append(entries, SparseEntry{i, s.columnIndices[k], s.values[k]})
+		}
+	}
+	return COOMatrix{s.rows, s.columns, entries}
+}
+
+// Возвращает количество строк матрицы.
+func (s SparseMatrix) Rows() int {
+	return s.rows
+}
+
+// Возвращает количество столбцов матрицы.
+func (s SparseMatrix) Columns() int {
+	return s.columns
+}
+
+// Возвращает количество хранимых ненулевых элементов.
+func (s SparseMatrix) NonZeros() int {
+	return len(s.values)
+}
+
+// Возвращает элемент матрицы в заданной строке и столбце (нумерация с нуля).
+// Элемент ищется двоичным поиском в строке. Если индекс выходит за пределы
+// матрицы, вызывается паника, как и в Matrix.At.
+func (s SparseMatrix) At(row int, column int) float64 {
+	start, end := s.rowPointers[row], s.rowPointers[row+1]
+	// Нулевые элементы не хранятся, поэтому столбец проверяется отдельно
+	if column < 0 || column >= s.columns {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", column, s.columns))
+	}
+	if k, found := slices.BinarySearch(s.columnIndices[start:end], column); found {
+		return s.values[start+k]
+	}
+	return 0
+}
+
+// Умножает каждый элемент матрицы на заданное число.
+func (s *SparseMatrix) MultiplyByNumber(number float64) {
+	for k := range s.values {
+		s.values[k] *= number
+	}
+}
+
+// Возвращает транспонированную матрицу.
+func (s SparseMatrix) Transpose() SparseMatrix {
+	result := SparseMatrix{
+		s.columns, s.rows, make([]int, s.columns+1),
+		make([]int, len(s.values)), make([]float64, len(s.values)),
+	}
+
+	// Считаем количество элементов в каждом столбце
+	for _, column := range s.columnIndices {
+		result.rowPointers[column+1]++
+	}
+	for j := 0; j < s.columns; j++ {
+		result.rowPointers[j+1] += result.rowPointers[j]
+	}
+
+	// Строки обходятся по порядку, поэтому в строках результата номера
+	// столбцов получаются упорядоченными
+	next := append([]int{}, result.rowPointers[:s.columns]...)
+	for i := 0; i < s.rows; i++ {
+		for k := s.rowPointers[i]; k < s.rowPointers[i+1]; k++ {
+			column := s.columnIndices[k]
+			result.columnIndices[next[column]] = i
+			result.values[next[column]] = s.values[k]
+			next[column]++
+		}
+	}
+	return result
+}
+
+// Прибавляет к каждому элементу матрицы элементы другой матрицы. Элементы,
+// ставшие нулевыми, удаляются.
+//
+// Если аргумент negative равен true, то будет произведено вычитание матриц.
+func (s *SparseMatrix) AddMatrix(other SparseMatrix, negative bool) error {
+	// У матриц должны быть равно количество строк и столбцов
+	if s.rows != other.rows || s.columns != other.columns {
+		return NotSameSizeError(s.rows, s.columns, other.rows, other.columns)
+	}
+
+	sign := 1.0
+	if negative {
+		sign = -1
+	}
+
+	rowPointers := make([]int, s.rows+1)
+	columnIndices := make([]int, 0, len(s.values)+len(other.values))
+	values := make([]float64, 0, len(s.values)+len(other.values))
+	appendValue := func(column int, value float64) {
+		if value != 0 {
+			columnIndices = append(columnIndices, column)
+			values = append(values, value)
+		}
+	}
+
+	// Сливаем упорядоченные строки обеих матриц
+	for i := 0; i < s.rows; i++ {
+		a, aEnd := s.rowPointers[i], s.rowPointers[i+1]
+		b, bEnd := other.rowPointers[i], other.rowPointers[i+1]
+		for a < aEnd || b < bEnd {
+			switch {
+			case b == bEnd || (a < aEnd && s.columnIndices[a] < other.columnIndices[b]):
+				appendValue(s.columnIndices[a], s.values[a])
+				a++
+			case a == aEnd || other.columnIndices[b] < s.columnIndices[a]:
+				appendValue(other.columnIndices[b], sign*other.values[b])
+				b++
+			default:
+				appendValue(s.columnIndices[a], s.values[a]+sign*other.values[b])
+				a++
+				b++
+			}
+		}
+		rowPointers[i+1] = len(values)
+	}
+
+	s.rowPointers, s.columnIndices, s.values = rowPointers, columnIndices, values
+	return nil
+}
+
+// Возвращает произведение матрицы на вектор.
+//
+// Возвращает ошибку, если длина вектора не равна количеству столбцов.
+func (s SparseMatrix) MultiplyVector(x []float64) ([]float64, error) {
+	if len(x) != s.columns {
+		return nil, UnableToMultiplyError(s.columns, len(x))
+	}
+	result := make([]float64, s.rows)
+	s.multiplyVector(x, result)
+	return result, nil
+}
+
+// Записывает произведение матрицы на вектор x в срез result.
+func (s SparseMatrix) multiplyVector(x []float64, result []float64) {
+	for i := 0; i < s.rows; i++ {
+		sum := 0.0
+		for k := s.rowPointers[i]; k < s.rowPointers[i+1]; k++ {
+			sum += s.values[k] * x[s.columnIndices[k]]
+		}
+		result[i] = sum
+	}
+}
+
+// Возвращает плотную матрицу, являющуюся результатом умножения разреженной
+// матрицы на заданную плотную.
+//
+// Возвращает ошибку, если матрицы нельзя перемножить.
+func (s SparseMatrix) MultiplyMatrix(other Matrix) (Matrix, error) {
+	// Число столбцов первой матрицы должно совпадать с числом строк второй
+	if s.columns != other.rows {
+		return Matrix{}, UnableToMultiplyError(s.columns, other.rows)
+	}
+
+	result := ZeroMatrix(s.rows, other.columns)
+	for i := 0; i < s.rows; i++ {
+		for k := s.rowPointers[i]; k < s.rowPointers[i+1]; k++ {
+			value := s.values[k]
+			row := other.row(s.columnIndices[k])
+			for j := 0; j < other.columns; j++ {
+				result.row(i)[j] += value * row[j]
+			}
+		}
+	}
+	return result, nil
+}
+
+// Возвращает true, если квадратная матрица симметрична с точностью Epsilon
+// относительно наибольшего по модулю элемента.
+func (s SparseMatrix) IsSymmetric() bool {
+	if s.rows != s.columns {
+		return false
+	}
+	difference := s.Transpose()
+	difference.AddMatrix(s, true)
+
+	scale := 0.0
+	for _, value := range s.values {
+		scale = math.Max(scale, math.Abs(value))
+	}
+	for _, value := range difference.values {
+		if math.Abs(value) > Epsilon*scale {
+			return false
+		}
+	}
+	return true
+}
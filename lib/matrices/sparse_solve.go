@@ -0,0 +1,233 @@
+package matrices
+
+import "math"
+
+// Итерационный метод решения системы линейных уравнений.
+type IterativeMethod byte
+
+const (
+	// Метод Якоби: все компоненты приближения пересчитываются по предыдущему
+	// приближению. Сходится для матриц со строгим диагональным преобладанием.
+	IterativeJacobi IterativeMethod = iota
+	// Метод Гаусса-Зейделя: при пересчёте используются уже обновлённые
+	// компоненты. Сходится для матриц с диагональным преобладанием и
+	// симметричных положительно определённых матриц.
+	IterativeGaussSeidel
+	// Метод сопряжённых градиентов для симметричных положительно
+	// определённых матриц.
+	IterativeConjugateGradient
+)
+
+// Максимальное количество итераций итерационных методов по умолчанию.
+const DefaultIterativeMaxIterations = 10000
+
+// Относительная невязка, при которой итерационные методы останавливаются,
+// по умолчанию.
+const DefaultIterativeTolerance = 1e-10
+
+// Параметры итерационного решения системы линейных уравнений.
+type IterativeOptions struct {
+	// Максимальное количество итераций. Если равно нулю, используется
+	// DefaultIterativeMaxIterations.
+	MaxIterations int
+	// Относительная невязка ‖b - Ax‖ / ‖b‖, при которой решение считается
+	// найденным. Если равна нулю, используется DefaultIterativeTolerance.
+	Tolerance float64
+	// Начальное приближение. Если пустое, начальное приближение нулевое.
+	Initial []float64
+}
+
+// Решение системы линейных уравнений итерационным методом.
+type IterativeSolution struct {
+	X          []float64 // Приближённое решение
+	Iterations int       // Количество выполненных итераций
+	Residual   float64   // Относительная невязка ‖b - Ax‖ / ‖b‖
+}
+
+func (o IterativeOptions) maxIterations() int {
+	if o.MaxIterations <= 0 {
+		return DefaultIterativeMaxIterations
+	}
+	return o.MaxIterations
+}
+
+func (o IterativeOptions) tolerance() float64 {
+	if o.Tolerance <= 0 {
+		return DefaultIterativeTolerance
+	}
+	return o.Tolerance
+}
+
+// Решает систему линейных уравнений Ax = b с разреженной матрицей заданным
+// итерационным методом с параметрами по умолчанию.
+func SolveIterative(a SparseMatrix, b []float64, method IterativeMethod) (IterativeSolution, error) {
+	return SolveIterativeWith(a, b, method, IterativeOptions{})
+}
+
+// Решает систему линейных уравнений Ax = b с разреженной матрицей заданным
+// итерационным методом с заданными параметрами.
+//
+// Возвращает ошибку, если матрица не квадратная, длина b или начального
+// приближения не равна порядку матрицы, способ неизвестен, на диагонали
+// есть нулевой элемент (методы Якоби и Гаусса-Зейделя), матрица не
+// симметрична или не положительно определена (метод сопряжённых
+// градиентов) или метод не сошёлся за заданное количество итераций.
+func SolveIterativeWith(a SparseMatrix, b []float64, method IterativeMethod, options IterativeOptions) (IterativeSolution, error) {
+	// Матрица должна быть квадратной
+	if a.rows != a.columns {
+		return IterativeSolution{}, NotSquareMatrixError()
+	}
+	if len(b) != a.rows {
+		return IterativeSolution{}, NotSameSizeError(a.rows, 1, len(b), 1)
+	}
+	if len(options.Initial) != 0 && len(options.Initial) != a.rows {
+		return IterativeSolution{}, NotSameSizeError(a.rows, 1, len(options.Initial), 1)
+	}
+
+	x := make([]float64, a.rows)
+	copy(x, options.Initial)
+
+	switch method {
+	case IterativeJacobi, IterativeGaussSeidel:
+		diagonal, err := a.diagonal()
+		if err != nil {
+			return IterativeSolution{}, err
+		}
+		return a.solveStationary(b, x, diagonal, method == IterativeGaussSeidel, options)
+	case IterativeConjugateGradient:
+		if !a.IsSymmetric() {
+			return IterativeSolution{}, NotSymmetricMatrixError()
+		}
+		return a.solveConjugateGradient(b, x, options)
+	}
+	return IterativeSolution{}, UnknownMethodError(byte(method))
+}
+
+// Возвращает диагональ квадратной матрицы.
+//
+// Возвращает ошибку, если на диагонали есть нулевой элемент.
+func (s SparseMatrix) diagonal() ([]float64, error) {
+	diagonal := make([]float64, s.rows)
+	for i := range diagonal {
+		diagonal[i] = s.At(i, i)
+		if diagonal[i] == 0 {
+			return nil, SingularMatrixError(i + 1)
+		}
+	}
+	return diagonal, nil
+}
+
+// Решает систему методом Якоби или, если seidel равен true, методом
+// Гаусса-Зейделя, начиная с приближения x.
+func (s SparseMatrix) solveStationary(b []float64, x []float64, diagonal []float64, seidel bool, options IterativeOptions) (IterativeSolution, error) {
+	norm := residualScale(b)
+	residual := make([]float64, s.rows)
+	previous := make([]float64, s.rows)
+	for iteration := 0; ; iteration++ {
+		relative := s.relativeResidual(b, x, residual, norm)
+		if relative <= options.tolerance() {
+			return IterativeSolution{x, iteration, relative}, nil
+		}
+		if iteration == options.maxIterations() || math.IsNaN(relative) || math.IsInf(relative, 0) {
+			return IterativeSolution{}, NoConvergenceError(iteration)
+		}
+
+		// В методе Якоби все компоненты вычисляются по предыдущему
+		// приближению, в методе Гаусса-Зейделя - по текущему
+		source := x
+		if !seidel {
+			copy(previous, x)
+			source = previous
+		}
+		for i := 0; i < s.rows; i++ {
+			sum := b[i]
+			for k := s.rowPointers[i]; k < s.rowPointers[i+1]; k++ {
+				if j := s.columnIndices[k]; j != i {
+					sum -= s.values[k] * source[j]
+				}
+			}
+			x[i] = sum / diagonal[i]
+		}
+	}
+}
+
+// Решает систему с симметричной матрицей методом сопряжённых градиентов,
+// начиная с приближения x.
+func (s SparseMatrix) solveConjugateGradient(b []float64, x []float64, options IterativeOptions) (IterativeSolution, error) {
+	norm := residualScale(b)
+	residual := make([]float64, s.rows)
+	relative := s.relativeResidual(b, x, residual, norm)
+
+	direction := append([]float64{}, residual...)
+	product := make([]float64, s.rows)
+	squared := dotProduct(residual, residual)
+	for iteration := 0; ; iteration++ {
+		if relative <= options.tolerance() {
+			// Невязка, пересчитанная по рекуррентной формуле, может отличаться
+			// от настоящей из-за ошибок округления
+			relative = s.relativeResidual(b, x, residual, norm)
+			return IterativeSolution{x, iteration, relative}, nil
+		}
+		if iteration == options.maxIterations() {
+			return IterativeSolution{}, NoConvergenceError(iteration)
+		}
+
+		s.multiplyVector(direction, product)
+		curvature := dotProduct(direction, product)
+		// Для положительно определённой матрицы (Ap, p) > 0
+		if curvature <= 0 {
+			return IterativeSolution{}, NotPositiveDefiniteError(iteration+1, curvature)
+		}
+
+		step := squared / curvature
+		for i := range x {
+			x[i] += step * direction[i]
+			residual[i] -= step * product[i]
+		}
+
+		next := dotProduct(residual, residual)
+		for i := range direction {
+			direction[i] = residual[i] + next/squared*direction[i]
+		}
+		squared = next
+		relative = math.Sqrt(squared) / norm
+	}
+}
+
+// Записывает в residual невязку b - Ax и возвращает её норму, делённую на
+// norm.
+func (s SparseMatrix) relativeResidual(b []float64, x []float64, residual []float64, norm float64) float64 {
+	s.multiplyVector(x, residual)
+	for i := range residual {
+		residual[i] = b[i] - residual[i]
+	}
+	return vectorNorm(residual) / norm
+}
+
+// Возвращает норму правой части, относительно которой измеряется невязка.
+// Для нулевой правой части невязка измеряется абсолютно.
+func residualScale(b []float64) float64 {
+	norm := vectorNorm(b)
+	if norm == 0 {
+		return 1
+	}
+	return norm
+}
+
+// Возвращает евклидову норму вектора.
+func vectorNorm(vector []float64) float64 {
+	norm := 0.0
+	for i := range vector {
+		norm = math.Hypot(norm, vector[i])
+	}
+	return norm
+}
+
+// Возвращает скалярное произведение векторов.
+func dotProduct(a []float64, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
@@ -0,0 +1,125 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Возвращает матрицу пятиточечной разностной схемы для уравнения Пуассона
+// -Δu = f на сетке n×n внутренних узлов.
+func poissonMatrix(n int) SparseMatrix {
+	coo, _ := NewCOOMatrix(n*n, n*n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			node := i*n + j
+			coo.Append(node, node, 4)
+			if i > 0 {
+				coo.Append(node, node-n, -1)
+			}
+			if i < n-1 {
+				coo.Append(node, node+n, -1)
+			}
+			if j > 0 {
+				coo.Append(node, node-1, -1)
+			}
+			if j < n-1 {
+				coo.Append(node, node+1, -1)
+			}
+		}
+	}
+	return coo.ToCSR()
+}
+
+// Итерационные методы решения систем
+func TestSolveIterative(t *testing.T) {
+	dominant, _ := NewMatrix([][]float64{{10, -1, 2, 0}, {-1, 11, -1, 3}, {2, -1, 10, -1}, {0, 3, -1, 8}})
+	b := []float64{6, 25, -11, 15}
+	want := []float64{1, 2, -1, 1}
+
+	for _, method := range []IterativeMethod{IterativeJacobi, IterativeGaussSeidel, IterativeConjugateGradient} {
+		testname := fmt.Sprintf("method=%d", method)
+		t.Run(testname, func(t *testing.T) {
+			solution, err := SolveIterative(NewSparseMatrixFromMatrix(dominant), b, method)
+			if err != nil {
+				t.Fatalf("got an error while calling SolveIterative: %v", err)
+			}
+			for i := range want {
+				if math.Abs(solution.X[i]-want[i]) > 1e-8 {
+					t.Errorf("got %v, want %v", solution.X, want)
+					break
+				}
+			}
+			if solution.Residual > DefaultIterativeTolerance {
+				t.Errorf("got residual %g, want at most %g", solution.Residual, DefaultIterativeTolerance)
+			}
+		})
+	}
+}
+
+// Итерационные методы на разностной сетке: Гаусс-Зейдель сходится быстрее
+// Якоби, а метод сопряжённых градиентов - быстрее обоих
+func TestSolveIterativePoisson(t *testing.T) {
+	const n = 16
+	a := poissonMatrix(n)
+	want := make([]float64, n*n)
+	for i := range want {
+		want[i] = math.Sin(float64(i))
+	}
+	b, _ := a.MultiplyVector(want)
+
+	iterations := make([]int, 3)
+	for method := IterativeJacobi; method <= IterativeConjugateGradient; method++ {
+		solution, err := SolveIterativeWith(a, b, method, IterativeOptions{Tolerance: 1e-9})
+		if err != nil {
+			t.Fatalf("got an error while solving by method %d: %v", method, err)
+		}
+		for i := range want {
+			if math.Abs(solution.X[i]-want[i]) > 1e-6 {
+				t.Fatalf("method %d: got %g at %d, want %g", method, solution.X[i], i, want[i])
+			}
+		}
+		iterations[method] = solution.Iterations
+	}
+	if !(iterations[IterativeConjugateGradient] < iterations[IterativeGaussSeidel] && iterations[IterativeGaussSeidel] < iterations[IterativeJacobi]) {
+		t.Errorf("got iterations %v, want decreasing", iterations)
+	}
+
+	// Начальное приближение, равное решению, не требует итераций
+	solution, _ := SolveIterativeWith(a, b, IterativeConjugateGradient, IterativeOptions{Initial: want, Tolerance: 1e-9})
+	if solution.Iterations != 0 {
+		t.Errorf("got %d iterations, want 0", solution.Iterations)
+	}
+}
+
+// Ошибки итерационных методов
+func TestSolveIterativeErrors(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		b        []float64
+		method   IterativeMethod
+		options  IterativeOptions
+		wantErr  error
+	}{
+		{[][]float64{{1, 2}}, []float64{1}, IterativeJacobi, IterativeOptions{}, NotSquareMatrixError()},
+		{[][]float64{{1, 0}, {0, 1}}, []float64{1}, IterativeJacobi, IterativeOptions{}, NotSameSizeError(2, 1, 1, 1)},
+		{[][]float64{{1, 0}, {0, 1}}, []float64{1, 1}, IterativeJacobi, IterativeOptions{Initial: []float64{1}}, NotSameSizeError(2, 1, 1, 1)},
+		{[][]float64{{1, 0}, {0, 1}}, []float64{1, 1}, 3, IterativeOptions{}, UnknownMethodError(3)},
+		{[][]float64{{1, 2}, {2, 0}}, []float64{1, 1}, IterativeGaussSeidel, IterativeOptions{}, SingularMatrixError(2)},
+		// Нет диагонального преобладания: метод Якоби расходится
+		{[][]float64{{1, 3}, {3, 1}}, []float64{1, 1}, IterativeJacobi, IterativeOptions{MaxIterations: 100}, NoConvergenceError(100)},
+		{[][]float64{{1, 2}, {0, 1}}, []float64{1, 1}, IterativeConjugateGradient, IterativeOptions{}, NotSymmetricMatrixError()},
+		{[][]float64{{1, 0}, {0, -1}}, []float64{0, 1}, IterativeConjugateGradient, IterativeOptions{}, NotPositiveDefiniteError(1, -1)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v,%v,%d", tt.elements, tt.b, tt.method)
+		t.Run(testname, func(t *testing.T) {
+			dense, _ := NewMatrix(tt.elements)
+			_, err := SolveIterativeWith(NewSparseMatrixFromMatrix(dense), tt.b, tt.method, tt.options)
+			if err == nil || err.Error() != tt.wantErr.Error() {
+				t.Errorf("got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package matrices
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Построение разреженной матрицы и преобразование форматов
+func TestSparseConversion(t *testing.T) {
+	coo, err := NewCOOMatrix(3, 4, []SparseEntry{
+		{2, 3, 5}, {0, 1, 2}, {1, 0, -1}, {0, 1, 3}, {2, 0, 4}, {1, 2, 0}, {1, 1, 7}, {1, 1, -7},
+	})
+	if err != nil {
+		t.Fatalf("got an error while calling NewCOOMatrix: %v", err)
+	}
+	want := [][]float64{{0, 5, 0, 0}, {-1, 0, 0, 0}, {4, 0, 0, 5}}
+	if got := coo.ToMatrix().rowSlices(); !approximatelyEqual(got, want, 0) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	sparse := coo.ToCSR()
+	if sparse.Rows() != 3 || sparse.Columns() != 4 {
+		t.Errorf("got size %dx%d, want 3x4", sparse.Rows(), sparse.Columns())
+	}
+	// Повторяющиеся элементы сложены, нулевые отброшены
+	if sparse.NonZeros() != 4 {
+		t.Errorf("got %d non-zeros, want 4", sparse.NonZeros())
+	}
+	if got := sparse.ToMatrix().rowSlices(); !approximatelyEqual(got, want, 0) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		for j := range want[i] {
+			if sparse.At(i, j) != want[i][j] {
+				t.Errorf("got %g at (%d, %d), want %g", sparse.At(i, j), i, j, want[i][j])
+			}
+		}
+	}
+
+	dense, _ := NewMatrix(want)
+	fromDense := NewSparseMatrixFromMatrix(dense)
+	if fmt.Sprint(fromDense) != fmt.Sprint(sparse) {
+		t.Errorf("got %v, want %v", fromDense, sparse)
+	}
+	if got := fromDense.ToCOO().Entries(); len(got) != 4 || got[0] != (SparseEntry{0, 1, 5}) {
+		t.Errorf("got entries %v", got)
+	}
+
+	identity := IdentitySparseMatrix(3).ToMatrix()
+	if !approximatelyEqual(identity.rowSlices(), IdentityMatrix(3).rowSlices(), 0) {
+		t.Errorf("got %v, want identity", identity.rowSlices())
+	}
+
+	// Элементы за пределами матрицы
+	if _, err := NewCOOMatrix(2, 2, []SparseEntry{{2, 0, 1}}); err == nil || err.Error() != IndexOutOfRangeError(3, 1).Error() {
+		t.Errorf("got %v, want %v", err, IndexOutOfRangeError(3, 1))
+	}
+	if err := coo.Append(0, -1, 1); err == nil || err.Error() != IndexOutOfRangeError(1, 0).Error() {
+		t.Errorf("got %v, want %v", err, IndexOutOfRangeError(1, 0))
+	}
+	if _, err := NewCOOMatrix(-3, 2, nil); err == nil || err.Error() != InvalidSizeError(-3, 2).Error() {
+		t.Errorf("got %v, want %v", err, InvalidSizeError(-3, 2))
+	}
+	if _, err := NewCOOMatrix(2, -1, nil); err == nil || err.Error() != InvalidSizeError(2, -1).Error() {
+		t.Errorf("got %v, want %v", err, InvalidSizeError(2, -1))
+	}
+
+	// Индекс за пределами строки не должен возвращать ноль
+	for _, index := range [][2]int{{0, 4}, {0, -1}, {3, 0}, {-1, 0}} {
+		if !panics(func() { sparse.At(index[0], index[1]) }) {
+			t.Errorf("got no panic for At(%d, %d)", index[0], index[1])
+		}
+	}
+	if !panics(func() { IdentitySparseMatrix(2).At(0, 5) }) {
+		t.Errorf("got no panic for At(0, 5)")
+	}
+}
+
+// Транспонирование разреженной матрицы
+func TestSparseTranspose(t *testing.T) {
+	tests := [][][]float64{
+		{{1, 0, 2}, {0, 0, 3}},
+		{{0, 0}, {0, 0}},
+		{{0, 4, 0, 0}, {5, 0, 0, 6}, {0, 0, 7, 0}},
+	}
+
+	for _, elements := range tests {
+		testname := fmt.Sprintf("%v", elements)
+		t.Run(testname, func(t *testing.T) {
+			dense, _ := NewMatrix(elements)
+			transposed := NewSparseMatrixFromMatrix(dense).Transpose()
+			want := dense.Transpose()
+			if !approximatelyEqual(transposed.ToMatrix().rowSlices(), want.rowSlices(), 0) {
+				t.Errorf("got %v, want %v", transposed.ToMatrix().rowSlices(), want.rowSlices())
+			}
+			// Номера столбцов в строках упорядочены
+			if fmt.Sprint(transposed) != fmt.Sprint(NewSparseMatrixFromMatrix(want)) {
+				t.Errorf("got %v, want %v", transposed, NewSparseMatrixFromMatrix(want))
+			}
+		})
+	}
+}
+
+// Сложение и вычитание разреженных матриц
+func TestSparseAddMatrix(t *testing.T) {
+	tests := []struct {
+		a        [][]float64
+		b        [][]float64
+		negative bool
+		want     [][]float64
+		nonZeros int
+		wantErr  error
+	}{
+		{
+			[][]float64{{1, 0, 2}, {0, 3, 0}}, [][]float64{{0, 4, -2}, {5, 0, 0}}, false,
+			[][]float64{{1, 4, 0}, {5, 3, 0}}, 4, nil,
+		},
+		{
+			[][]float64{{1, 0}, {0, 3}}, [][]float64{{1, 0}, {2, 3}}, true,
+			[][]float64{{0, 0}, {-2, 0}}, 1, nil,
+		},
+		{[][]float64{{1, 0}}, [][]float64{{1}, {0}}, false, nil, 0, NotSameSizeError(1, 2, 2, 1)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v,%v,%t", tt.a, tt.b, tt.negative)
+		t.Run(testname, func(t *testing.T) {
+			a, _ := NewMatrix(tt.a)
+			b, _ := NewMatrix(tt.b)
+			sparse := NewSparseMatrixFromMatrix(a)
+			err := sparse.AddMatrix(NewSparseMatrixFromMatrix(b), tt.negative)
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while calling AddMatrix: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !approximatelyEqual(sparse.ToMatrix().rowSlices(), tt.want, 0) {
+				t.Errorf("got %v, want %v", sparse.ToMatrix().rowSlices(), tt.want)
+			}
+			if sparse.NonZeros() != tt.nonZeros {
+				t.Errorf("got %d non-zeros, want %d", sparse.NonZeros(), tt.nonZeros)
+			}
+		})
+	}
+}
+
+// Умножение разреженной матрицы на вектор и плотную матрицу
+func TestSparseMultiply(t *testing.T) {
+	a, _ := NewMatrix([][]float64{{1, 0, 2}, {0, 0, 0}, {0, -3, 4}})
+	b, _ := NewMatrix([][]float64{{1, 2}, {3, 4}, {5, 6}})
+	sparse := NewSparseMatrixFromMatrix(a)
+
+	product, err := sparse.MultiplyMatrix(b)
+	if err != nil {
+		t.Fatalf("got an error while calling MultiplyMatrix: %v", err)
+	}
+	want, _ := a.MultiplyMatrix(b)
+	if !approximatelyEqual(product.rowSlices(), want.rowSlices(), 0) {
+		t.Errorf("got %v, want %v", product.rowSlices(), want.rowSlices())
+	}
+
+	vector, _ := sparse.MultiplyVector([]float64{1, 2, 3})
+	if fmt.Sprint(vector) != "[7 0 6]" {
+		t.Errorf("got %v, want [7 0 6]", vector)
+	}
+
+	sparse.MultiplyByNumber(2)
+	if sparse.At(2, 2) != 8 {
+		t.Errorf("got %g, want 8", sparse.At(2, 2))
+	}
+
+	if _, err := sparse.MultiplyMatrix(b.Transpose()); err == nil || err.Error() != UnableToMultiplyError(3, 2).Error() {
+		t.Errorf("got %v, want %v", err, UnableToMultiplyError(3, 2))
+	}
+	if _, err := sparse.MultiplyVector([]float64{1}); err == nil || err.Error() != UnableToMultiplyError(3, 1).Error() {
+		t.Errorf("got %v, want %v", err, UnableToMultiplyError(3, 1))
+	}
+}
@@ -0,0 +1,195 @@
+package matrices
+
+// Способ умножения матриц.
+type MultiplyMethod byte
+
+const (
+	// Классическое (блочное) умножение по определению, O(n³).
+	MultiplyClassical MultiplyMethod = iota
+	// Алгоритм Штрассена: 7 умножений и 18 сложений блоков вместо
+	// 8 умножений, O(n^2.81).
+	MultiplyStrassen
+	// Вариант Винограда алгоритма Штрассена: 7 умножений и 15 сложений
+	// блоков.
+	MultiplyWinograd
+)
+
+// Размер, начиная с которого алгоритмы Штрассена и Винограда делят матрицы
+// на блоки. Если хотя бы одна из размерностей перемножаемых блоков не больше
+// этого числа, блоки перемножаются классическим способом: для небольших
+// матриц экономия на умножениях не окупает дополнительных сложений.
+var StrassenCrossover = 256
+
+// Возвращает матрицу, являющуюся результатом умножения текущей матрицы на
+// другую заданную, заданным способом.
+//
+// Алгоритмы Штрассена и Винограда работают с матрицами любых размеров: если
+// размерность блока нечётная, последняя строка или столбец отделяются, и их
+// вклад в произведение вычисляется классическим способом.
+//
+// Возвращает ошибку, если матрицы нельзя перемножить или способ неизвестен.
+func (m Matrix) MultiplyMatrixBy(other Matrix, method MultiplyMethod) (Matrix, error) {
+	// Число столбцов первой матрицы должно совпадать с числом строк второй
+	if m.columns != other.rows {
+		return Matrix{}, UnableToMultiplyError(m.columns, other.rows)
+	}
+
+	switch method {
+	case MultiplyClassical:
+		return m.MultiplyMatrix(other)
+	case MultiplyStrassen, MultiplyWinograd:
+		return strassenMultiply(m, other, method == MultiplyWinograd, max(StrassenCrossover, 1)), nil
+	}
+	return Matrix{}, UnknownMethodError(byte(method))
+}
+
+// Возвращает сумму (sign = 1) или разность (sign = -1) матриц одинакового
+// размера.
+func combineMatrices(a Matrix, b Matrix, sign float64) Matrix {
+	result := ZeroMatrix(a.rows, a.columns)
+	for i := 0; i < a.rows; i++ {
+		row, aRow, bRow := result.row(i), a.row(i), b.row(i)
+		for j := range row {
+			row[j] = aRow[j] + sign*bRow[j]
+		}
+	}
+	return result
+}
+
+// Прибавляет к матрице destination матрицу source, умноженную на sign.
+func accumulateMatrix(destination Matrix, source Matrix, sign float64) {
+	for i := 0; i < destination.rows; i++ {
+		row, sourceRow := destination.row(i), source.row(i)
+		for j := range row {
+			row[j] += sign * sourceRow[j]
+		}
+	}
+}
+
+// Возвращает произведение матриц, вычисленное алгоритмом Штрассена или, если
+// winograd равен true, алгоритмом Винограда.
+func strassenMultiply(a Matrix, b Matrix, winograd bool, crossover int) Matrix {
+	rows, inner, columns := a.rows, a.columns, b.columns
+	if min(rows, inner, columns) <= crossover {
+		result, _ := a.MultiplyMatrix(b)
+		return result
+	}
+
+	// Делим на блоки чётную часть матриц, отделяя последние строку и столбец
+	// при нечётных размерностях
+	evenRows, evenInner, evenColumns := rows&^1, inner&^1, columns&^1
+	result := ZeroMatrix(rows, columns)
+	even := strassenEven(a.view(0, 0, evenRows, evenInner), b.view(0, 0, evenInner, evenColumns), winograd, crossover)
+	for i := 0; i < evenRows; i++ {
+		copy(result.row(i), even.row(i))
+	}
+
+	// Вклад последнего столбца A и последней строки B
+	if evenInner != inner {
+		bRow := b.row(inner - 1)[:evenColumns]
+		for i := 0; i < evenRows; i++ {
+			factor := a.row(i)[inner-1]
+			row := result.row(i)[:evenColumns]
+			for j := range row {
+				row[j] += factor * bRow[j]
+			}
+		}
+	}
+	// Последний столбец результата
+	if evenColumns != columns {
+		for i := 0; i < evenRows; i++ {
+			sum := 0.0
+			for k, value := range a.row(i) {
+				sum += value * b.row(k)[columns-1]
+			}
+			result.row(i)[columns-1] = sum
+		}
+	}
+	// Последняя строка результата
+	if evenRows != rows {
+		row := result.row(rows - 1)
+		for k, factor := range a.row(rows - 1) {
+			for j, value := range b.row(k) {
+				row[j] += factor * value
+			}
+		}
+	}
+	return result
+}
+
+// Возвращает произведение матриц с чётными размерностями, разбивая каждую
+// матрицу на четыре блока.
+func strassenEven(a Matrix, b Matrix, winograd bool, crossover int) Matrix {
+	rows, inner, columns := a.rows/2, a.columns/2, b.columns/2
+	a11, a12 := a.view(0, 0, rows, inner), a.view(0, inner, rows, inner)
+	a21, a22 := a.view(rows, 0, rows, inner), a.view(rows, inner, rows, inner)
+	b11, b12 := b.view(0, 0, inner, columns), b.view(0, columns, inner, columns)
+	b21, b22 := b.view(inner, 0, inner, columns), b.view(inner, columns, inner, columns)
+	multiply := func(x Matrix, y Matrix) Matrix {
+		return strassenMultiply(x, y, winograd, crossover)
+	}
+
+	result := ZeroMatrix(2*rows, 2*columns)
+	c11, c12 := result.view(0, 0, rows, columns), result.view(0, columns, rows, columns)
+	c21, c22 := result.view(rows, 0, rows, columns), result.view(rows, columns, rows, columns)
+
+	if winograd {
+		s1 := combineMatrices(a21, a22, 1)
+		s2 := combineMatrices(s1, a11, -1)
+		s3 := combineMatrices(a11, a21, -1)
+		s4 := combineMatrices(a12, s2, -1)
+		t1 := combineMatrices(b12, b11, -1)
+		t2 := combineMatrices(b22, t1, -1)
+		t3 := combineMatrices(b22, b12, -1)
+		t4 := combineMatrices(t2, b21, -1)
+
+		p1 := multiply(a11, b11)
+		p5 := multiply(s1, t1)
+		// U2 = P1 + P6, U3 = U2 + P7, U4 = U2 + P5
+		u2 := multiply(s2, t2)
+		accumulateMatrix(u2, p1, 1)
+		u3 := multiply(s3, t3)
+		accumulateMatrix(u3, u2, 1)
+
+		// C11 = P1 + P2
+		accumulateMatrix(c11, p1, 1)
+		accumulateMatrix(c11, multiply(a12, b21), 1)
+		// C12 = U4 + P3
+		accumulateMatrix(c12, u2, 1)
+		accumulateMatrix(c12, p5, 1)
+		accumulateMatrix(c12, multiply(s4, b22), 1)
+		// C21 = U3 - P4
+		accumulateMatrix(c21, u3, 1)
+		accumulateMatrix(c21, multiply(a22, t4), -1)
+		// C22 = U3 + P5
+		accumulateMatrix(c22, u3, 1)
+		accumulateMatrix(c22, p5, 1)
+		return result
+	}
+
+	m1 := multiply(combineMatrices(a11, a22, 1), combineMatrices(b11, b22, 1))
+	m2 := multiply(combineMatrices(a21, a22, 1), b11)
+	m3 := multiply(a11, combineMatrices(b12, b22, -1))
+	m4 := multiply(a22, combineMatrices(b21, b11, -1))
+	m5 := multiply(combineMatrices(a11, a12, 1), b22)
+	m6 := multiply(combineMatrices(a21, a11, -1), combineMatrices(b11, b12, 1))
+	m7 := multiply(combineMatrices(a12, a22, -1), combineMatrices(b21, b22, 1))
+
+	// C11 = M1 + M4 - M5 + M7
+	accumulateMatrix(c11, m1, 1)
+	accumulateMatrix(c11, m4, 1)
+	accumulateMatrix(c11, m5, -1)
+	accumulateMatrix(c11, m7, 1)
+	// C12 = M3 + M5
+	accumulateMatrix(c12, m3, 1)
+	accumulateMatrix(c12, m5, 1)
+	// C21 = M2 + M4
+	accumulateMatrix(c21, m2, 1)
+	accumulateMatrix(c21, m4, 1)
+	// C22 = M1 - M2 + M3 + M6
+	accumulateMatrix(c22, m1, 1)
+	accumulateMatrix(c22, m2, -1)
+	accumulateMatrix(c22, m3, 1)
+	accumulateMatrix(c22, m6, 1)
+	return result
+}
@@ -0,0 +1,112 @@
+package matrices
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// Возвращает матрицу заданного размера со случайными целыми элементами от
+// -9 до 9. Произведения таких матриц вычисляются без ошибок округления.
+func randomIntegerMatrix(random *rand.Rand, rows int, columns int) Matrix {
+	result := ZeroMatrix(rows, columns)
+	for i := range result.data {
+		result.data[i] = float64(random.Intn(19) - 9)
+	}
+	return result
+}
+
+// Алгоритмы Штрассена и Винограда дают то же произведение, что и
+// классическое умножение
+func TestMultiplyMatrixBy(t *testing.T) {
+	defer func(crossover int) { StrassenCrossover = crossover }(StrassenCrossover)
+	random := rand.New(rand.NewSource(1))
+	tests := []struct {
+		rows      int
+		inner     int
+		columns   int
+		crossover int
+	}{
+		{1, 1, 1, 1},
+		{2, 2, 2, 1},
+		{8, 8, 8, 1},
+		{9, 9, 9, 2},
+		{17, 13, 20, 2},
+		{64, 33, 65, 4},
+		{100, 100, 100, 8},
+		{3, 50, 2, 1},
+		{40, 40, 40, 100},
+	}
+
+	for _, tt := range tests {
+		for _, method := range []MultiplyMethod{MultiplyStrassen, MultiplyWinograd} {
+			testname := fmt.Sprintf("%dx%dx%d,crossover=%d,method=%d", tt.rows, tt.inner, tt.columns, tt.crossover, method)
+			t.Run(testname, func(t *testing.T) {
+				StrassenCrossover = tt.crossover
+				a := randomIntegerMatrix(random, tt.rows, tt.inner)
+				b := randomIntegerMatrix(random, tt.inner, tt.columns)
+				got, err := a.MultiplyMatrixBy(b, method)
+				if err != nil {
+					t.Fatalf("got an error while calling MultiplyMatrixBy: %v", err)
+				}
+				want, _ := a.MultiplyMatrixBy(b, MultiplyClassical)
+				if !approximatelyEqual(got.rowSlices(), want.rowSlices(), 0) {
+					t.Errorf("got %v, want %v", got.rowSlices(), want.rowSlices())
+				}
+			})
+		}
+	}
+
+	// Для чисел с плавающей точкой результаты совпадают с точностью до
+	// ошибок округления
+	StrassenCrossover = 16
+	a := randomMatrix(random, 150, 130)
+	b := randomMatrix(random, 130, 170)
+	want, _ := a.MultiplyMatrix(b)
+	for _, method := range []MultiplyMethod{MultiplyStrassen, MultiplyWinograd} {
+		got, _ := a.MultiplyMatrixBy(b, method)
+		if !approximatelyEqual(got.rowSlices(), want.rowSlices(), 1e-10) {
+			t.Errorf("method %d: product differs from the classical one", method)
+		}
+	}
+
+	if _, err := a.MultiplyMatrixBy(a, MultiplyStrassen); err == nil || err.Error() != UnableToMultiplyError(130, 150).Error() {
+		t.Errorf("got %v, want %v", err, UnableToMultiplyError(130, 150))
+	}
+	if _, err := a.MultiplyMatrixBy(b, 3); err == nil || err.Error() != UnknownMethodError(3).Error() {
+		t.Errorf("got %v, want %v", err, UnknownMethodError(3))
+	}
+}
+
+// Сравнение классического умножения с алгоритмами Штрассена и Винограда
+func BenchmarkMultiplyMatrixBy(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	for _, n := range []int{256, 512, 1024, 2048} {
+		x := randomMatrix(random, n, n)
+		y := randomMatrix(random, n, n)
+		for _, method := range []MultiplyMethod{MultiplyClassical, MultiplyStrassen, MultiplyWinograd} {
+			b.Run(fmt.Sprintf("method=%d/%d", method, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					x.MultiplyMatrixBy(y, method)
+				}
+			})
+		}
+	}
+}
+
+// Время алгоритма Штрассена в зависимости от размера, начиная с которого
+// блоки перемножаются классическим способом
+func BenchmarkStrassenCrossover(b *testing.B) {
+	defer func(crossover int) { StrassenCrossover = crossover }(StrassenCrossover)
+	random := rand.New(rand.NewSource(1))
+	x := randomMatrix(random, 1024, 1024)
+	y := randomMatrix(random, 1024, 1024)
+	for _, crossover := range []int{32, 64, 128, 256, 512} {
+		b.Run(fmt.Sprintf("crossover=%d", crossover), func(b *testing.B) {
+			StrassenCrossover = crossover
+			for i := 0; i < b.N; i++ {
+				x.MultiplyMatrixBy(y, MultiplyStrassen)
+			}
+		})
+	}
+}
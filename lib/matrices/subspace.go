@@ -0,0 +1,157 @@
+package matrices
+
+import "math/big"
+
+// Возвращает ранг матрицы: количество ведущих элементов ступенчатого вида.
+// Элементы, меньшие Epsilon относительно наибольшего элемента матрицы,
+// считаются нулями.
+func (m Matrix) Rank() int {
+	return m.RankTolerance(Epsilon)
+}
+
+// Возвращает ранг матрицы, считая нулями элементы, меньшие tolerance
+// относительно наибольшего элемента матрицы.
+//
+// Пример: ранг матрицы [[1 2] [1 2.000001]] равен 2 при tolerance = 1e-10 и
+// равен 1 при tolerance = 1e-3.
+func (m Matrix) RankTolerance(tolerance float64) int {
+	_, pivots, _ := m.rowReduceTolerance(false, tolerance)
+	return len(pivots)
+}
+
+// Возвращает базис нуль-пространства (ядра) матрицы: векторы x, для которых
+// Ax = 0. Каждой свободной переменной упрощённого ступенчатого вида
+// соответствует один вектор базиса.
+func (m Matrix) NullSpace() [][]float64 {
+	return m.NullSpaceTolerance(Epsilon)
+}
+
+// Возвращает базис нуль-пространства матрицы, считая нулями элементы,
+// меньшие tolerance относительно наибольшего элемента матрицы. Количество
+// векторов базиса равно m.Columns() - m.RankTolerance(tolerance).
+func (m Matrix) NullSpaceTolerance(tolerance float64) [][]float64 {
+	reduced, pivots, _ := m.rowReduceTolerance(true, tolerance)
+	return nullSpaceElements(RealField{}, reduced.rowSlices(), pivots, m.columns)
+}
+
+// Возвращает базис пространства столбцов (образа) матрицы: столбцы исходной
+// матрицы, которым соответствуют ведущие элементы ступенчатого вида.
+func (m Matrix) ColumnSpace() [][]float64 {
+	return m.ColumnSpaceTolerance(Epsilon)
+}
+
+// Возвращает базис пространства столбцов матрицы, считая нулями элементы,
+// меньшие tolerance относительно наибольшего элемента матрицы. Количество
+// векторов базиса равно m.RankTolerance(tolerance).
+func (m Matrix) ColumnSpaceTolerance(tolerance float64) [][]float64 {
+	_, pivots, _ := m.rowReduceTolerance(false, tolerance)
+	return columnSpaceElements(m.rowSlices(), pivots)
+}
+
+// Возвращает базис пространства строк матрицы: ненулевые строки упрощённого
+// ступенчатого вида.
+func (m Matrix) RowSpace() [][]float64 {
+	return m.RowSpaceTolerance(Epsilon)
+}
+
+// Возвращает базис пространства строк матрицы, считая нулями элементы,
+// меньшие tolerance относительно наибольшего элемента матрицы. Количество
+// векторов базиса равно m.RankTolerance(tolerance).
+func (m Matrix) RowSpaceTolerance(tolerance float64) [][]float64 {
+	reduced, pivots, _ := m.rowReduceTolerance(true, tolerance)
+	return reduced.rowSlices()[:len(pivots)]
+}
+
+// Возвращает ранг матрицы, вычисленный точно.
+func (r RationalMatrix) Rank() int {
+	pivots, _ := rowReduceElements(RationalField{}, cloneElements(r.elements), r.columns, false)
+	return len(pivots)
+}
+
+// Возвращает базис нуль-пространства (ядра) матрицы.
+func (r RationalMatrix) NullSpace() [][]*big.Rat {
+	reduced := cloneElements(r.elements)
+	pivots, _ := rowReduceElements(RationalField{}, reduced, r.columns, true)
+	return nullSpaceElements(RationalField{}, reduced, pivots, r.columns)
+}
+
+// Возвращает базис пространства столбцов (образа) матрицы: столбцы исходной
+// матрицы, которым соответствуют ведущие элементы ступенчатого вида.
+func (r RationalMatrix) ColumnSpace() [][]*big.Rat {
+	pivots, _ := rowReduceElements(RationalField{}, cloneElements(r.elements), r.columns, false)
+	return columnSpaceElements(r.elements, pivots)
+}
+
+// Возвращает базис пространства строк матрицы: ненулевые строки упрощённого
+// ступенчатого вида.
+func (r RationalMatrix) RowSpace() [][]*big.Rat {
+	reduced := cloneElements(r.elements)
+	pivots, _ := rowReduceElements(RationalField{}, reduced, r.columns, true)
+	return reduced[:len(pivots)]
+}
+
+// Возвращает ранг матрицы.
+func (m FieldMatrix[T]) Rank() int {
+	pivots, _ := rowReduceElements(m.field, cloneElements(m.elements), m.columns, false)
+	return len(pivots)
+}
+
+// Возвращает базис нуль-пространства (ядра) матрицы.
+func (m FieldMatrix[T]) NullSpace() [][]T {
+	reduced := cloneElements(m.elements)
+	pivots, _ := rowReduceElements(m.field, reduced, m.columns, true)
+	return nullSpaceElements(m.field, reduced, pivots, m.columns)
+}
+
+// Возвращает базис пространства столбцов (образа) матрицы.
+func (m FieldMatrix[T]) ColumnSpace() [][]T {
+	pivots, _ := rowReduceElements(m.field, cloneElements(m.elements), m.columns, false)
+	return columnSpaceElements(m.elements, pivots)
+}
+
+// Возвращает базис пространства строк матрицы.
+func (m FieldMatrix[T]) RowSpace() [][]T {
+	reduced := cloneElements(m.elements)
+	pivots, _ := rowReduceElements(m.field, reduced, m.columns, true)
+	return reduced[:len(pivots)]
+}
+
+// Возвращает базис решений однородной системы по упрощённому ступенчатому
+// виду её матрицы из columns столбцов и номерам столбцов с ведущими
+// элементами. Свободная переменная вектора базиса равна единице, остальные
+// свободные переменные - нулю.
+func nullSpaceElements[T any](field Field[T], reduced [][]T, pivots []int, columns int) [][]T {
+	isPivot := make([]bool, columns)
+	for _, column := range pivots {
+		isPivot[column] = true
+	}
+
+	basis := [][]T{}
+	for free := 0; free < columns; free++ {
+		if isPivot[free] {
+			continue
+		}
+		vector := make([]T, columns)
+		for i := range vector {
+			vector[i] = field.Zero()
+		}
+		vector[free] = field.One()
+		for row, column := range pivots {
+			vector[column] = field.Neg(reduced[row][free])
+		}
+		basis = append(basis, vector)
+	}
+	return basis
+}
+
+// Возвращает столбцы матрицы с заданными номерами.
+func columnSpaceElements[T any](elements [][]T, pivots []int) [][]T {
+	basis := make([][]T, len(pivots))
+	for k, column := range pivots {
+		basis[k] = make([]T, len(elements))
+		for i := range elements {
+			basis[k][i] = elements[i][column]
+		}
+	}
+	return basis
+}
@@ -0,0 +1,148 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Ранг и базисы подпространств матрицы действительных чисел
+func TestMatrixSubspaces(t *testing.T) {
+	tests := []struct {
+		elements    [][]float64
+		rank        int
+		nullSpace   [][]float64
+		columnSpace [][]float64
+		rowSpace    [][]float64
+	}{
+		{
+			[][]float64{{1, 2}, {3, 4}}, 2,
+			[][]float64{}, [][]float64{{1, 3}, {2, 4}}, [][]float64{{1, 0}, {0, 1}},
+		},
+		{
+			[][]float64{{1, 2, 3}, {2, 4, 6}}, 1,
+			[][]float64{{-2, 1, 0}, {-3, 0, 1}}, [][]float64{{1, 2}}, [][]float64{{1, 2, 3}},
+		},
+		{
+			[][]float64{{1, 0, 1}, {0, 1, 1}, {1, 1, 2}}, 2,
+			[][]float64{{-1, -1, 1}}, [][]float64{{1, 0, 1}, {0, 1, 1}}, [][]float64{{1, 0, 1}, {0, 1, 1}},
+		},
+		{
+			[][]float64{{0, 0}, {0, 0}}, 0,
+			[][]float64{{1, 0}, {0, 1}}, [][]float64{}, [][]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, err := NewMatrix(tt.elements)
+			if err != nil {
+				t.Fatalf("got an error while initializing Matrix: %v", err)
+			}
+			if rank := matrix.Rank(); rank != tt.rank {
+				t.Errorf("got rank %d, want %d", rank, tt.rank)
+			}
+			if got := matrix.NullSpace(); !approximatelyEqual(got, tt.nullSpace, 1e-9) {
+				t.Errorf("got null space %v, want %v", got, tt.nullSpace)
+			}
+			if got := matrix.ColumnSpace(); !approximatelyEqual(got, tt.columnSpace, 1e-9) {
+				t.Errorf("got column space %v, want %v", got, tt.columnSpace)
+			}
+			if got := matrix.RowSpace(); !approximatelyEqual(got, tt.rowSpace, 1e-9) {
+				t.Errorf("got row space %v, want %v", got, tt.rowSpace)
+			}
+
+			// Векторы нуль-пространства удовлетворяют Ax = 0
+			for _, vector := range matrix.NullSpace() {
+				for i := 0; i < matrix.rows; i++ {
+					sum := 0.0
+					for j := 0; j < matrix.columns; j++ {
+						sum += matrix.row(i)[j] * vector[j]
+					}
+					if math.Abs(sum) > 1e-9 {
+						t.Errorf("vector %v is not in null space", vector)
+					}
+				}
+			}
+		})
+	}
+}
+
+// Ранг с заданной точностью
+func TestMatrixRankTolerance(t *testing.T) {
+	matrix, _ := NewMatrix([][]float64{{1, 2}, {1, 2.000001}})
+	tests := []struct {
+		tolerance float64
+		want      int
+	}{
+		{1e-10, 2},
+		{1e-3, 1},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%g", tt.tolerance)
+		t.Run(testname, func(t *testing.T) {
+			if rank := matrix.RankTolerance(tt.tolerance); rank != tt.want {
+				t.Errorf("got %d, want %d", rank, tt.want)
+			}
+			// Размеры базисов согласованы с рангом при той же точности
+			if got := len(matrix.ColumnSpaceTolerance(tt.tolerance)); got != tt.want {
+				t.Errorf("got column space of size %d, want %d", got, tt.want)
+			}
+			if got := len(matrix.RowSpaceTolerance(tt.tolerance)); got != tt.want {
+				t.Errorf("got row space of size %d, want %d", got, tt.want)
+			}
+			if got := len(matrix.NullSpaceTolerance(tt.tolerance)); got != matrix.Columns()-tt.want {
+				t.Errorf("got null space of size %d, want %d", got, matrix.Columns()-tt.want)
+			}
+		})
+	}
+}
+
+// Точные ранг и базисы подпространств рациональной матрицы
+func TestRationalMatrixSubspaces(t *testing.T) {
+	// Матрица почти вырождена, но точный ранг равен 3
+	matrix, _ := NewIntegerRationalMatrix([][]int64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+	matrix.elements[2][2].SetFrac64(90000000001, 10000000000)
+
+	if rank := matrix.Rank(); rank != 3 {
+		t.Errorf("got rank %d, want 3", rank)
+	}
+
+	singular, _ := NewIntegerRationalMatrix([][]int64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+	if rank := singular.Rank(); rank != 2 {
+		t.Errorf("got rank %d, want 2", rank)
+	}
+	want := "[[1 -2 1]]"
+	if got := fmt.Sprintf("%v", ratStrings(RationalMatrix{1, 3, singular.NullSpace()})); got != want {
+		t.Errorf("got null space %v, want %v", got, want)
+	}
+	want = "[[1 4 7] [2 5 8]]"
+	if got := fmt.Sprintf("%v", ratStrings(RationalMatrix{2, 3, singular.ColumnSpace()})); got != want {
+		t.Errorf("got column space %v, want %v", got, want)
+	}
+	want = "[[1 0 -1] [0 1 2]]"
+	if got := fmt.Sprintf("%v", ratStrings(RationalMatrix{2, 3, singular.RowSpace()})); got != want {
+		t.Errorf("got row space %v, want %v", got, want)
+	}
+}
+
+// Ранг и нуль-пространство матрицы над конечным полем
+func TestFieldMatrixSubspaces(t *testing.T) {
+	field := ModularField{5}
+	matrix, _ := NewFieldMatrix[int64](field, [][]int64{{1, 2}, {3, 1}})
+
+	if rank := matrix.Rank(); rank != 1 {
+		t.Errorf("got rank %d, want 1", rank)
+	}
+	if got, want := fmt.Sprintf("%v", matrix.NullSpace()), "[[3 1]]"; got != want {
+		t.Errorf("got null space %v, want %v", got, want)
+	}
+	if got, want := fmt.Sprintf("%v", matrix.ColumnSpace()), "[[1 3]]"; got != want {
+		t.Errorf("got column space %v, want %v", got, want)
+	}
+	if got, want := fmt.Sprintf("%v", matrix.RowSpace()), "[[1 2]]"; got != want {
+		t.Errorf("got row space %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,255 @@
+package matrices
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Сингулярное разложение матрицы размера m x n: A = UΣVᵀ. Разложение
+// "экономное": при k = min(m, n) матрица U имеет размер m x k, а V - размер
+// n x k.
+type SVDDecomposition struct {
+	U     Matrix    // Матрица с ортонормированными столбцами (левые сингулярные векторы)
+	Sigma []float64 // Сингулярные числа в порядке убывания
+	V     Matrix    // Матрица с ортонормированными столбцами (правые сингулярные векторы)
+}
+
+// Возвращает сингулярное разложение матрицы, найденное односторонним
+// методом Якоби: столбцы матрицы попарно вращаются, пока не станут
+// ортогональными. Тогда длины столбцов равны сингулярным числам.
+//
+// Возвращает ошибку, если метод не сошёлся за DefaultMaxIterations проходов.
+func (m Matrix) SVD() (SVDDecomposition, error) {
+	// Метод работает со столбцами, поэтому широкую матрицу транспонируем:
+	// если Aᵀ = UΣVᵀ, то A = VΣUᵀ
+	if m.rows < m.columns {
+		svd, err := m.Transpose().SVD()
+		if err != nil {
+			return SVDDecomposition{}, err
+		}
+		return SVDDecomposition{svd.V, svd.Sigma, svd.U}, nil
+	}
+
+	const eps = 0x1p-52
+	u := m.clone()
+	v := IdentityMatrix(m.columns)
+	uRows, vRows := u.rowSlices(), v.rowSlices()
+	converged := false
+	for sweep := 0; sweep < DefaultMaxIterations && !converged; sweep++ {
+		converged = true
+		for p := 0; p < m.columns; p++ {
+			for q := p + 1; q < m.columns; q++ {
+				alpha, beta, gamma := 0.0, 0.0, 0.0
+				for i := 0; i < m.rows; i++ {
+					alpha += u.row(i)[p] * u.row(i)[p]
+					beta += u.row(i)[q] * u.row(i)[q]
+					gamma += u.row(i)[p] * u.row(i)[q]
+				}
+				// Столбцы уже ортогональны
+				if math.Abs(gamma) <= eps*math.Sqrt(alpha*beta) {
+					continue
+				}
+				converged = false
+
+				// Вращение, после которого столбцы p и q ортогональны
+				zeta := (beta - alpha) / (2 * gamma)
+				t := 1 / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(1+t*t)
+				s := c * t
+				rotateColumns(uRows, p, q, c, s)
+				rotateColumns(vRows, p, q, c, s)
+			}
+		}
+	}
+	if !converged {
+		return SVDDecomposition{}, NoConvergenceError(DefaultMaxIterations)
+	}
+
+	// Сингулярные числа - длины столбцов, упорядочиваем их по убыванию
+	sigma := make([]float64, m.columns)
+	for j := range sigma {
+		for i := 0; i < m.rows; i++ {
+			sigma[j] = math.Hypot(sigma[j], u.row(i)[j])
+		}
+	}
+	order := make([]int, m.columns)
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i int, j int) int {
+		return cmp.Compare(sigma[j], sigma[i])
+	})
+
+	svd := SVDDecomposition{ZeroMatrix(m.rows, m.columns), make([]float64, m.columns), ZeroMatrix(m.columns, m.columns)}
+	for k, j := range order {
+		svd.Sigma[k] = sigma[j]
+		for i := 0; i < m.rows; i++ {
+			if sigma[j] != 0 {
+				svd.U.row(i)[k] = u.row(i)[j] / sigma[j]
+			}
+		}
+		for i := 0; i < m.columns; i++ {
+			svd.V.row(i)[k] = v.row(i)[j]
+		}
+	}
+	svd.U.completeOrthonormalColumns(svd.Sigma)
+	return svd, nil
+}
+
+// Возвращает сингулярные числа матрицы в порядке убывания.
+//
+// Возвращает ошибку, если сингулярное разложение не сошлось.
+func (m Matrix) SingularValues() ([]float64, error) {
+	svd, err := m.SVD()
+	if err != nil {
+		return nil, err
+	}
+	return svd.Sigma, nil
+}
+
+// Возвращает численный ранг матрицы: количество сингулярных чисел, больших
+// Epsilon относительно наибольшего. В отличие от Rank, результат не зависит
+// от выбора ведущих элементов.
+//
+// Возвращает ошибку, если сингулярное разложение не сошлось.
+func (m Matrix) NumericalRank() (int, error) {
+	svd, err := m.SVD()
+	if err != nil {
+		return 0, err
+	}
+	return svd.Rank(), nil
+}
+
+// Возвращает спектральную норму матрицы ||A||₂, равную наибольшему
+// сингулярному числу.
+//
+// Возвращает ошибку, если сингулярное разложение не сошлось.
+func (m Matrix) SpectralNorm() (float64, error) {
+	svd, err := m.SVD()
+	if err != nil {
+		return 0, err
+	}
+	return svd.Norm(), nil
+}
+
+// Возвращает спектральное число обусловленности матрицы: отношение
+// наибольшего сингулярного числа к наименьшему. Для вырожденной матрицы
+// число обусловленности равно +Inf.
+//
+// Возвращает ошибку, если сингулярное разложение не сошлось.
+func (m Matrix) ConditionNumber() (float64, error) {
+	svd, err := m.SVD()
+	if err != nil {
+		return 0, err
+	}
+	return svd.ConditionNumber(), nil
+}
+
+// Возвращает псевдообратную матрицу Мура-Пенроуза A⁺ = VΣ⁺Uᵀ, где в Σ⁺
+// сингулярные числа, большие Epsilon относительно наибольшего, заменены
+// обратными, а остальные - нулями. Для невырожденной квадратной матрицы
+// псевдообратная совпадает с обратной, а x = A⁺b является решением
+// системы Ax = b по методу наименьших квадратов с наименьшей длиной.
+//
+// Возвращает ошибку, если сингулярное разложение не сошлось.
+func (m Matrix) PseudoInverse() (Matrix, error) {
+	svd, err := m.SVD()
+	if err != nil {
+		return Matrix{}, err
+	}
+
+	rank := svd.Rank()
+	result := ZeroMatrix(m.columns, m.rows)
+	for i := 0; i < m.columns; i++ {
+		for j := 0; j < m.rows; j++ {
+			for k := 0; k < rank; k++ {
+				result.row(i)[j] += svd.V.row(i)[k] * svd.U.row(j)[k] / svd.Sigma[k]
+			}
+		}
+	}
+	return result, nil
+}
+
+// Возвращает численный ранг: количество сингулярных чисел, больших Epsilon
+// относительно наибольшего.
+func (d SVDDecomposition) Rank() int {
+	rank := 0
+	for _, sigma := range d.Sigma {
+		if sigma > Epsilon*d.Norm() {
+			rank++
+		}
+	}
+	return rank
+}
+
+// Возвращает спектральную норму: наибольшее сингулярное число.
+func (d SVDDecomposition) Norm() float64 {
+	if len(d.Sigma) == 0 {
+		return 0
+	}
+	return d.Sigma[0]
+}
+
+// Возвращает число обусловленности: отношение наибольшего сингулярного
+// числа к наименьшему.
+func (d SVDDecomposition) ConditionNumber() float64 {
+	if len(d.Sigma) == 0 {
+		return 0
+	}
+	smallest := d.Sigma[len(d.Sigma)-1]
+	if smallest == 0 {
+		return math.Inf(1)
+	}
+	return d.Sigma[0] / smallest
+}
+
+// Заменяет столбцы, соответствующие нулевым (меньшим Epsilon относительно
+// наибольшего) сингулярным числам, векторами, дополняющими остальные
+// столбцы до ортонормированной системы.
+func (m *Matrix) completeOrthonormalColumns(sigma []float64) {
+	valid := make([]bool, len(sigma))
+	for k := range sigma {
+		valid[k] = sigma[k] > Epsilon*sigma[0]
+	}
+
+	for k := range sigma {
+		if valid[k] {
+			continue
+		}
+		// Из векторов стандартного базиса выбираем тот, у которого после
+		// ортогонализации к имеющимся столбцам остаётся наибольшая часть
+		var best []float64
+		bestNorm := 0.0
+		for e := 0; e < m.rows; e++ {
+			v := make([]float64, m.rows)
+			v[e] = 1
+			for j := range sigma {
+				if !valid[j] {
+					continue
+				}
+				projection := 0.0
+				for i := 0; i < m.rows; i++ {
+					projection += m.row(i)[j] * v[i]
+				}
+				for i := 0; i < m.rows; i++ {
+					v[i] -= projection * m.row(i)[j]
+				}
+			}
+			norm := 0.0
+			for i := 0; i < m.rows; i++ {
+				norm = math.Hypot(norm, v[i])
+			}
+			if norm > bestNorm {
+				best, bestNorm = v, norm
+			}
+		}
+		for i := 0; i < m.rows; i++ {
+			m.row(i)[k] = best[i] / bestNorm
+		}
+		valid[k] = true
+	}
+}
@@ -0,0 +1,134 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Сингулярное разложение
+func TestSVD(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		sigma    []float64
+	}{
+		{[][]float64{{3, 0}, {0, -2}}, []float64{3, 2}},
+		{[][]float64{{3, 2, 2}, {2, 3, -2}}, []float64{5, 3}},
+		{[][]float64{{1, 2}, {3, 4}, {5, 6}}, []float64{9.525518091565107, 0.5143005806586441}},
+		{[][]float64{{1, 2}, {2, 4}}, []float64{5, 0}},
+		{[][]float64{{0, 0, 0}, {0, 0, 0}}, []float64{0, 0}},
+		{[][]float64{{2, 0, 0, 0}, {0, 0, 3, 0}, {0, 0, 0, 0}, {0, 4, 0, 0}}, []float64{4, 3, 2, 0}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			svd, err := matrix.SVD()
+			if err != nil {
+				t.Fatalf("got an error while calculating SVD: %v", err)
+			}
+			if !approximatelyEqual([][]float64{svd.Sigma}, [][]float64{tt.sigma}, 1e-9) {
+				t.Errorf("got Σ = %v, want %v", svd.Sigma, tt.sigma)
+			}
+
+			// Столбцы U и V ортонормированы
+			k := len(tt.sigma)
+			for _, factor := range []Matrix{svd.U, svd.V} {
+				gram, _ := factor.Transpose().MultiplyMatrix(factor)
+				if !approximatelyEqual(gram.rowSlices(), IdentityMatrix(k).rowSlices(), 1e-9) {
+					t.Errorf("got non-orthonormal columns %v", factor.rowSlices())
+				}
+			}
+
+			// A = UΣVᵀ
+			scaled := svd.U.clone()
+			for i := 0; i < scaled.rows; i++ {
+				for j := 0; j < scaled.columns; j++ {
+					scaled.row(i)[j] *= svd.Sigma[j]
+				}
+			}
+			product, _ := scaled.MultiplyMatrix(svd.V.Transpose())
+			if !approximatelyEqual(product.rowSlices(), tt.elements, 1e-9) {
+				t.Errorf("got UΣVᵀ = %v, want %v", product.rowSlices(), tt.elements)
+			}
+		})
+	}
+}
+
+// Численный ранг, спектральная норма и число обусловленности
+func TestSVDProperties(t *testing.T) {
+	tests := []struct {
+		elements  [][]float64
+		rank      int
+		norm      float64
+		condition float64
+	}{
+		{[][]float64{{3, 0}, {0, -2}}, 2, 3, 1.5},
+		{[][]float64{{3, 2, 2}, {2, 3, -2}}, 2, 5, 5.0 / 3},
+		{[][]float64{{1, 2}, {2, 4}}, 1, 5, math.Inf(1)},
+		{[][]float64{{1, 1}, {1, 1 + 1e-12}}, 1, 2, 4e12},
+		{[][]float64{}, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			rank, err := matrix.NumericalRank()
+			if err != nil {
+				t.Fatalf("got an error while calculating NumericalRank: %v", err)
+			}
+			if rank != tt.rank {
+				t.Errorf("got rank %d, want %d", rank, tt.rank)
+			}
+			if norm, _ := matrix.SpectralNorm(); math.Abs(norm-tt.norm) > 1e-9 {
+				t.Errorf("got norm %g, want %g", norm, tt.norm)
+			}
+			condition, _ := matrix.ConditionNumber()
+			// Малое сингулярное число почти вырожденной матрицы вычисляется
+			// с относительной погрешностью около 1e-4
+			if math.IsInf(tt.condition, 1) != math.IsInf(condition, 1) ||
+				!math.IsInf(condition, 1) && math.Abs(condition-tt.condition) > 1e-3*max(1, tt.condition) {
+				t.Errorf("got condition number %g, want %g", condition, tt.condition)
+			}
+		})
+	}
+}
+
+// Псевдообратная матрица
+func TestPseudoInverse(t *testing.T) {
+	tests := []struct {
+		elements [][]float64
+		want     [][]float64
+	}{
+		// Для невырожденной матрицы совпадает с обратной
+		{[][]float64{{4, 7}, {2, 6}}, [][]float64{{0.6, -0.7}, {-0.2, 0.4}}},
+		// Для матрицы с линейно независимыми столбцами A⁺ = (AᵀA)⁻¹Aᵀ
+		{[][]float64{{1, 0}, {0, 1}, {1, 1}}, [][]float64{{2.0 / 3, -1.0 / 3, 1.0 / 3}, {-1.0 / 3, 2.0 / 3, 1.0 / 3}}},
+		{[][]float64{{1, 2}, {2, 4}}, [][]float64{{0.04, 0.08}, {0.08, 0.16}}},
+		{[][]float64{{1, 2, 3}}, [][]float64{{1.0 / 14}, {2.0 / 14}, {3.0 / 14}}},
+		{[][]float64{{0, 0}}, [][]float64{{0}, {0}}},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v", tt.elements)
+		t.Run(testname, func(t *testing.T) {
+			matrix, _ := NewMatrix(tt.elements)
+			pseudoInverse, err := matrix.PseudoInverse()
+			if err != nil {
+				t.Fatalf("got an error while calculating PseudoInverse: %v", err)
+			}
+			if !approximatelyEqual(pseudoInverse.rowSlices(), tt.want, 1e-9) {
+				t.Errorf("got %v, want %v", pseudoInverse.rowSlices(), tt.want)
+			}
+
+			// Условие Мура-Пенроуза: AA⁺A = A
+			product, _ := matrix.MultiplyMatrix(pseudoInverse)
+			product, _ = product.MultiplyMatrix(matrix)
+			if !approximatelyEqual(product.rowSlices(), tt.elements, 1e-9) {
+				t.Errorf("got AA⁺A = %v, want %v", product.rowSlices(), tt.elements)
+			}
+		})
+	}
+}
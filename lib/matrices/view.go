@@ -0,0 +1,254 @@
+package matrices
+
+// Подматрица, образованная заданными строками и столбцами исходной матрицы.
+// Представление не копирует элементы: чтение и запись элементов
+// представления читают и изменяют элементы исходной матрицы.
+type MatrixView struct {
+	matrix  Matrix // Исходная матрица
+	rows    []int  // Номера строк исходной матрицы
+	columns []int  // Номера столбцов исходной матрицы
+}
+
+// Возвращает подматрицу, ссылающуюся на элементы исходной матрицы. Срез
+// элементов заканчивается на последнем элементе подматрицы, поэтому
+// обращение к строке за её пределами вызывает панику.
+func (m Matrix) view(row int, column int, rows int, columns int) Matrix {
+	if rows == 0 || columns == 0 {
+		return ZeroMatrix(rows, columns)
+	}
+	start := row*m.stride + column
+	end := start + (rows-1)*m.stride + columns
+	return Matrix{rows, columns, m.stride, m.data[start:end:end]}
+}
+
+// Возвращает подматрицу из строк с firstRow по lastRow-1 и столбцов с
+// firstColumn по lastColumn-1 (нумерация с нуля, как при срезе в Go).
+// Элементы не копируются: подматрица и исходная матрица используют общее
+// хранилище, поэтому изменение одной изменяет другую. Чтобы получить
+// независимую копию, используйте Elements или NewMatrix.
+//
+// Возвращает ошибку, если диапазон выходит за пределы матрицы или его
+// начало больше конца.
+func (m Matrix) Slice(firstRow int, lastRow int, firstColumn int, lastColumn int) (Matrix, error) {
+	if firstRow < 0 || firstColumn < 0 || firstRow > lastRow || firstColumn > lastColumn {
+		return Matrix{}, IndexOutOfRangeError(firstRow+1, firstColumn+1)
+	}
+	if lastRow > m.rows || lastColumn > m.columns {
+		return Matrix{}, IndexOutOfRangeError(lastRow, lastColumn)
+	}
+	return m.view(firstRow, firstColumn, lastRow-firstRow, lastColumn-firstColumn), nil
+}
+
+// Возвращает подматрицу из строк с first по last-1, ссылающуюся на
+// элементы исходной матрицы (см. Slice).
+//
+// Возвращает ошибку, если диапазон выходит за пределы матрицы.
+func (m Matrix) RowRange(first int, last int) (Matrix, error) {
+	return m.Slice(first, last, 0, m.columns)
+}
+
+// Возвращает подматрицу из столбцов с first по last-1, ссылающуюся на
+// элементы исходной матрицы (см. Slice).
+//
+// Возвращает ошибку, если диапазон выходит за пределы матрицы.
+func (m Matrix) ColumnRange(first int, last int) (Matrix, error) {
+	return m.Slice(0, m.rows, first, last)
+}
+
+// Возвращает представление подматрицы, образованной заданными строками и
+// столбцами в заданном порядке (нумерация с нуля). Номера могут
+// повторяться. Элементы не копируются.
+//
+// Возвращает ошибку, если какой-либо номер выходит за пределы матрицы.
+func (m Matrix) View(rows []int, columns []int) (MatrixView, error) {
+	for _, row := range rows {
+		if row < 0 || row >= m.rows {
+			return MatrixView{}, IndexOutOfRangeError(row+1, 1)
+		}
+	}
+	for _, column := range columns {
+		if column < 0 || column >= m.columns {
+			return MatrixView{}, IndexOutOfRangeError(1, column+1)
+		}
+	}
+	return MatrixView{m, append([]int{}, rows...), append([]int{}, columns...)}, nil
+}
+
+// Возвращает копию подматрицы, образованной заданными строками и столбцами
+// в заданном порядке (нумерация с нуля).
+//
+// Возвращает ошибку, если какой-либо номер выходит за пределы матрицы.
+func (m Matrix) Submatrix(rows []int, columns []int) (Matrix, error) {
+	view, err := m.View(rows, columns)
+	if err != nil {
+		return Matrix{}, err
+	}
+	return view.ToMatrix(), nil
+}
+
+// Возвращает количество строк представления.
+func (v MatrixView) Rows() int {
+	return len(v.rows)
+}
+
+// Возвращает количество столбцов представления.
+func (v MatrixView) Columns() int {
+	return len(v.columns)
+}
+
+// Возвращает элемент представления в заданной строке и столбце (нумерация
+// с нуля).
+func (v MatrixView) At(row int, column int) float64 {
+	return v.matrix.At(v.rows[row], v.columns[column])
+}
+
+// Изменяет элемент исходной матрицы, соответствующий заданной строке и
+// столбцу представления (нумерация с нуля).
+func (v MatrixView) Set(row int, column int, value float64) {
+	v.matrix.Set(v.rows[row], v.columns[column], value)
+}
+
+// Возвращает новую матрицу с копией элементов представления.
+func (v MatrixView) ToMatrix() Matrix {
+	result := ZeroMatrix(len(v.rows), len(v.columns))
+	for i, row := range v.rows {
+		source, destination := v.matrix.row(row), result.row(i)
+		for j, column := range v.columns {
+			destination[j] = source[column]
+		}
+	}
+	return result
+}
+
+// Изменяет элемент матрицы в заданной строке и столбце (нумерация с нуля).
+// Если матрица является подматрицей, полученной Slice, изменяется и
+// исходная матрица. Индекс за пределами матрицы вызывает панику, как и в At.
+func (m *Matrix) Set(row int, column int, value float64) {
+	m.row(row)[column] = value
+}
+
+// Копирует элементы заданной матрицы в блок текущей матрицы, левый верхний
+// элемент которого находится в заданной строке и столбце (нумерация с нуля).
+//
+// Возвращает ошибку, если блок выходит за пределы матрицы.
+func (m *Matrix) SetBlock(row int, column int, block Matrix) error {
+	if row < 0 || column < 0 || row+block.rows > m.rows || column+block.columns > m.columns {
+		return IndexOutOfRangeError(row+block.rows, column+block.columns)
+	}
+	for i := 0; i < block.rows; i++ {
+		copy(m.row(row + i)[column:], block.row(i))
+	}
+	return nil
+}
+
+// Возвращает копию строки матрицы (нумерация с нуля).
+func (m Matrix) Row(row int) []float64 {
+	return append([]float64{}, m.row(row)...)
+}
+
+// Возвращает копию столбца матрицы (нумерация с нуля).
+func (m Matrix) Column(column int) []float64 {
+	result := make([]float64, m.rows)
+	for i := range result {
+		result[i] = m.row(i)[column]
+	}
+	return result
+}
+
+// Возвращает матрицу-строку из заданных чисел.
+func RowMatrix(values []float64) Matrix {
+	result := ZeroMatrix(1, len(values))
+	copy(result.data, values)
+	return result
+}
+
+// Возвращает матрицу-столбец из заданных чисел. Например, расширенная
+// матрица системы [A|b] - это HStack(a, ColumnMatrix(b)).
+func ColumnMatrix(values []float64) Matrix {
+	result := ZeroMatrix(len(values), 1)
+	copy(result.data, values)
+	return result
+}
+
+// Возвращает матрицу, составленную из заданных матриц, записанных слева
+// направо: [A B ...].
+//
+// Возвращает ошибку, если у матриц разное количество строк.
+func HStack(matrices ...Matrix) (Matrix, error) {
+	return BlockMatrix([][]Matrix{matrices})
+}
+
+// Возвращает матрицу, составленную из заданных матриц, записанных сверху
+// вниз.
+//
+// Возвращает ошибку, если у матриц разное количество столбцов.
+func VStack(matrices ...Matrix) (Matrix, error) {
+	blocks := make([][]Matrix, len(matrices))
+	for i := range matrices {
+		blocks[i] = []Matrix{matrices[i]}
+	}
+	return BlockMatrix(blocks)
+}
+
+// Возвращает блочно-диагональную матрицу с заданными блоками на диагонали.
+// Остальные элементы равны нулю.
+func BlockDiag(matrices ...Matrix) Matrix {
+	rows, columns := 0, 0
+	for _, block := range matrices {
+		rows += block.rows
+		columns += block.columns
+	}
+	result := ZeroMatrix(rows, columns)
+	row, column := 0, 0
+	for _, block := range matrices {
+		result.SetBlock(row, column, block)
+		row += block.rows
+		column += block.columns
+	}
+	return result
+}
+
+// Возвращает матрицу, составленную из блоков: blocks[i][j] - блок в i-й
+// строке и j-м столбце сетки. У блоков одной строки сетки должно быть
+// одинаковое количество строк, у блоков одного столбца - одинаковое
+// количество столбцов.
+//
+// Возвращает ошибку, если в строках сетки разное количество блоков или
+// размеры блоков не согласованы. Ожидаемый размер блока в ошибке
+// определяется первым блоком его строки и первым блоком его столбца.
+func BlockMatrix(blocks [][]Matrix) (Matrix, error) {
+	if len(blocks) == 0 || len(blocks[0]) == 0 {
+		return ZeroMatrix(0, 0), nil
+	}
+
+	// Размеры строк и столбцов сетки определяются первым столбцом и первой
+	// строкой блоков
+	rows, columns := 0, 0
+	for _, block := range blocks[0] {
+		columns += block.columns
+	}
+	for i := range blocks {
+		if len(blocks[i]) != len(blocks[0]) {
+			return Matrix{}, InvalidMatrixError(i + 1)
+		}
+		for j, block := range blocks[i] {
+			first, top := blocks[i][0], blocks[0][j]
+			if block.rows != first.rows || block.columns != top.columns {
+				return Matrix{}, NotSameSizeError(first.rows, top.columns, block.rows, block.columns)
+			}
+		}
+		rows += blocks[i][0].rows
+	}
+
+	result := ZeroMatrix(rows, columns)
+	row := 0
+	for i := range blocks {
+		column := 0
+		for _, block := range blocks[i] {
+			result.SetBlock(row, column, block)
+			column += block.columns
+		}
+		row += blocks[i][0].rows
+	}
+	return result, nil
+}
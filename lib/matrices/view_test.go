@@ -0,0 +1,239 @@
+package matrices
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Подматрицы, ссылающиеся на элементы исходной матрицы
+func TestSlice(t *testing.T) {
+	matrix, _ := NewMatrix([][]float64{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 12}})
+	tests := []struct {
+		firstRow    int
+		lastRow     int
+		firstColumn int
+		lastColumn  int
+		want        [][]float64
+		wantErr     error
+	}{
+		{0, 3, 0, 4, [][]float64{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 12}}, nil},
+		{1, 3, 1, 3, [][]float64{{6, 7}, {10, 11}}, nil},
+		{2, 3, 3, 4, [][]float64{{12}}, nil},
+		{0, 1, 0, 4, [][]float64{{1, 2, 3, 4}}, nil},
+		{3, 3, 4, 4, [][]float64{}, nil},
+		{0, 4, 0, 1, nil, IndexOutOfRangeError(4, 1)},
+		{0, 1, 2, 5, nil, IndexOutOfRangeError(1, 5)},
+		{-1, 1, 0, 1, nil, IndexOutOfRangeError(0, 1)},
+		{2, 1, 0, 1, nil, IndexOutOfRangeError(3, 1)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("[%d:%d,%d:%d]", tt.firstRow, tt.lastRow, tt.firstColumn, tt.lastColumn)
+		t.Run(testname, func(t *testing.T) {
+			slice, err := matrix.Slice(tt.firstRow, tt.lastRow, tt.firstColumn, tt.lastColumn)
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while calling Slice: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !approximatelyEqual(slice.Elements(), tt.want, 0) {
+				t.Errorf("got %v, want %v", slice.Elements(), tt.want)
+			}
+		})
+	}
+
+	// Изменение подматрицы изменяет исходную матрицу
+	block, _ := matrix.Slice(1, 3, 2, 4)
+	block.Set(0, 0, 70)
+	block.MultiplyByNumber(-1)
+	want := [][]float64{{1, 2, 3, 4}, {5, 6, -70, -8}, {9, 10, -11, -12}}
+	if !approximatelyEqual(matrix.Elements(), want, 0) {
+		t.Errorf("got %v, want %v", matrix.Elements(), want)
+	}
+
+	// Запись за пределами подматрицы не должна изменять исходную матрицу
+	corner, _ := matrix.Slice(0, 1, 0, 1)
+	for _, index := range [][2]int{{0, 1}, {1, 0}, {0, -1}} {
+		if !panics(func() { corner.Set(index[0], index[1], 42) }) {
+			t.Errorf("got no panic for Set(%d, %d)", index[0], index[1])
+		}
+		if !panics(func() { corner.At(index[0], index[1]) }) {
+			t.Errorf("got no panic for At(%d, %d)", index[0], index[1])
+		}
+	}
+	if !panics(func() { matrix.Set(0, 4, 99) }) {
+		t.Errorf("got no panic for Set(0, 4)")
+	}
+	if !approximatelyEqual(matrix.Elements(), want, 0) {
+		t.Errorf("got %v, want %v", matrix.Elements(), want)
+	}
+
+	// Подматрица подматрицы
+	rows, _ := matrix.RowRange(1, 3)
+	columns, _ := rows.ColumnRange(1, 2)
+	if got := columns.Column(0); fmt.Sprint(got) != "[6 10]" {
+		t.Errorf("got %v, want [6 10]", got)
+	}
+
+	// Алгоритмы работают с подматрицами так же, как с обычными матрицами
+	square, _ := matrix.Slice(0, 2, 0, 2)
+	determinator, _ := square.Determinator()
+	if determinator != -4 {
+		t.Errorf("got %g, want -4", determinator)
+	}
+	transposed := square.Transpose()
+	if !approximatelyEqual(transposed.Elements(), [][]float64{{1, 5}, {2, 6}}, 0) {
+		t.Errorf("got %v, want [[1 5] [2 6]]", transposed.Elements())
+	}
+}
+
+// Представления с произвольными наборами строк и столбцов и копии подматриц
+func TestViewSubmatrix(t *testing.T) {
+	matrix, _ := NewMatrix([][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+	tests := []struct {
+		rows    []int
+		columns []int
+		want    [][]float64
+		wantErr error
+	}{
+		{[]int{0, 2}, []int{0, 2}, [][]float64{{1, 3}, {7, 9}}, nil},
+		{[]int{2, 1, 0}, []int{1}, [][]float64{{8}, {5}, {2}}, nil},
+		{[]int{1, 1}, []int{2, 0}, [][]float64{{6, 4}, {6, 4}}, nil},
+		{[]int{}, []int{0}, [][]float64{}, nil},
+		{[]int{3}, []int{0}, nil, IndexOutOfRangeError(4, 1)},
+		{[]int{0}, []int{-1}, nil, IndexOutOfRangeError(1, 0)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v,%v", tt.rows, tt.columns)
+		t.Run(testname, func(t *testing.T) {
+			submatrix, err := matrix.Submatrix(tt.rows, tt.columns)
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while calling Submatrix: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !approximatelyEqual(submatrix.Elements(), tt.want, 0) {
+				t.Errorf("got %v, want %v", submatrix.Elements(), tt.want)
+			}
+			view, _ := matrix.View(tt.rows, tt.columns)
+			if view.Rows() != len(tt.rows) || view.Columns() != len(tt.columns) {
+				t.Errorf("got view size %dx%d, want %dx%d", view.Rows(), view.Columns(), len(tt.rows), len(tt.columns))
+			}
+			for i := range tt.want {
+				for j := range tt.want[i] {
+					if view.At(i, j) != tt.want[i][j] {
+						t.Errorf("got %g at (%d, %d), want %g", view.At(i, j), i, j, tt.want[i][j])
+					}
+				}
+			}
+		})
+	}
+
+	// Запись через представление изменяет исходную матрицу, а копия - нет
+	view, _ := matrix.View([]int{2, 0}, []int{1, 2})
+	copied := view.ToMatrix()
+	view.Set(0, 1, 90)
+	if matrix.At(2, 2) != 90 || copied.At(0, 1) != 9 {
+		t.Errorf("got %g in the matrix and %g in the copy, want 90 and 9", matrix.At(2, 2), copied.At(0, 1))
+	}
+
+	// Представление не позволяет выйти за свои пределы
+	if !panics(func() { view.Set(0, 2, 1) }) || !panics(func() { view.At(2, 0) }) {
+		t.Errorf("got no panic for an index outside the view")
+	}
+}
+
+// Строки и столбцы матрицы в виде векторов
+func TestRowColumn(t *testing.T) {
+	matrix, _ := NewMatrix([][]float64{{1, 2, 3}, {4, 5, 6}})
+	row := matrix.Row(1)
+	column := matrix.Column(2)
+	if fmt.Sprint(row) != "[4 5 6]" || fmt.Sprint(column) != "[3 6]" {
+		t.Errorf("got row %v and column %v, want [4 5 6] and [3 6]", row, column)
+	}
+	// Векторы являются копиями
+	row[0] = 100
+	column[0] = 100
+	if matrix.At(1, 0) != 4 || matrix.At(0, 2) != 3 {
+		t.Errorf("got %v, want the matrix unchanged", matrix.Elements())
+	}
+
+	if got := RowMatrix([]float64{1, 2}).Elements(); !approximatelyEqual(got, [][]float64{{1, 2}}, 0) {
+		t.Errorf("got %v, want [[1 2]]", got)
+	}
+	if got := ColumnMatrix([]float64{1, 2}).Elements(); !approximatelyEqual(got, [][]float64{{1}, {2}}, 0) {
+		t.Errorf("got %v, want [[1] [2]]", got)
+	}
+}
+
+// Составление матриц из блоков
+func TestBlockMatrix(t *testing.T) {
+	a, _ := NewMatrix([][]float64{{1, 2}, {3, 4}})
+	b := ColumnMatrix([]float64{5, 6})
+	c := RowMatrix([]float64{7, 8, 9})
+	d, _ := NewMatrix([][]float64{{1}})
+
+	tests := []struct {
+		name    string
+		build   func() (Matrix, error)
+		want    [][]float64
+		wantErr error
+	}{
+		{"HStack", func() (Matrix, error) { return HStack(a, b) }, [][]float64{{1, 2, 5}, {3, 4, 6}}, nil},
+		{"HStack empty", func() (Matrix, error) { return HStack() }, [][]float64{}, nil},
+		{"HStack mismatch", func() (Matrix, error) { return HStack(a, c) }, nil, NotSameSizeError(2, 3, 1, 3)},
+		{"VStack", func() (Matrix, error) { return VStack(a, a) }, [][]float64{{1, 2}, {3, 4}, {1, 2}, {3, 4}}, nil},
+		{"VStack mismatch", func() (Matrix, error) { return VStack(a, c) }, nil, NotSameSizeError(1, 2, 1, 3)},
+		{"BlockDiag", func() (Matrix, error) { return BlockDiag(a, d, b), nil }, [][]float64{
+			{1, 2, 0, 0}, {3, 4, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 5}, {0, 0, 0, 6},
+		}, nil},
+		{"BlockMatrix", func() (Matrix, error) {
+			return BlockMatrix([][]Matrix{{a, b}, {c.view(0, 0, 1, 2), d}})
+		}, [][]float64{{1, 2, 5}, {3, 4, 6}, {7, 8, 1}}, nil},
+		{"BlockMatrix jagged", func() (Matrix, error) {
+			return BlockMatrix([][]Matrix{{a, b}, {c}})
+		}, nil, InvalidMatrixError(2)},
+		{"BlockMatrix mismatch", func() (Matrix, error) {
+			return BlockMatrix([][]Matrix{{a, b}, {c, d}})
+		}, nil, NotSameSizeError(1, 2, 1, 3)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.build()
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("got an error while building the matrix: %v", err)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("got %q, want %q", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !approximatelyEqual(result.Elements(), tt.want, 0) {
+				t.Errorf("got %v, want %v", result.Elements(), tt.want)
+			}
+		})
+	}
+
+	// Расширенная матрица системы [A|b] и запись блока
+	augmented, _ := HStack(a, b)
+	if err := augmented.SetBlock(1, 1, RowMatrix([]float64{0, 0})); err != nil {
+		t.Fatalf("got an error while calling SetBlock: %v", err)
+	}
+	if !approximatelyEqual(augmented.Elements(), [][]float64{{1, 2, 5}, {3, 0, 0}}, 0) {
+		t.Errorf("got %v, want [[1 2 5] [3 0 0]]", augmented.Elements())
+	}
+	if err := augmented.SetBlock(1, 2, a); err == nil || err.Error() != IndexOutOfRangeError(3, 4).Error() {
+		t.Errorf("got %v, want %v", err, IndexOutOfRangeError(3, 4))
+	}
+}
@@ -0,0 +1,108 @@
+package permutations
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wadrodrog/math-helper/lib/explain"
+)
+
+// Возвращает количество инверсий перестановки и объяснение: список всех
+// найденных пар (i, j), в которых элементы стоят в обратном порядке.
+func (p *Permutation) InversionsExplained() (int, *explain.Trace) {
+	trace := explain.NewTrace("Количество инверсий", "Inversions count")
+	trace.Add("Перестановка", "Permutation", fmt.Sprint(p.values))
+
+	inversions := 0
+	for i := 0; i < p.size; i++ {
+		for j := i + 1; j < p.size; j++ {
+			if p.values[i] > p.values[j] {
+				inversions++
+				trace.Add(
+					fmt.Sprintf("Инверсия в позициях (%d, %d)", i+1, j+1),
+					fmt.Sprintf("Inversion at positions (%d, %d)", i+1, j+1),
+					fmt.Sprintf("%d > %d", p.values[i], p.values[j]),
+				)
+			}
+		}
+	}
+
+	trace.SetResult(fmt.Sprint(inversions))
+	return inversions, trace
+}
+
+// Возвращает разложение перестановки на циклы и объяснение: как строится
+// каждый цикл и какие элементы остаются на месте.
+func (p *Permutation) CyclesExplained() ([][]int, *explain.Trace) {
+	trace := explain.NewTrace("Разложение на циклы", "Cycle decomposition")
+	cycles := p.Cycles()
+
+	// Элементы, которые остаются на месте, образуют циклы длины 1
+	for i := 0; i < p.size; i++ {
+		arg := p.arguments[i]
+		if p.associations[arg] == arg {
+			trace.Add(
+				fmt.Sprintf("Элемент %d остаётся на месте, цикл длины 1 не записывается", arg),
+				fmt.Sprintf("Element %d is fixed, cycle of length 1 is omitted", arg),
+				fmt.Sprintf("%d → %d", arg, arg),
+			)
+		}
+	}
+
+	for _, cycle := range cycles {
+		path := make([]string, len(cycle)+1)
+		for i, element := range cycle {
+			path[i] = fmt.Sprint(element)
+		}
+		path[len(cycle)] = path[0]
+		trace.Add(
+			fmt.Sprintf("Начинаем цикл с %d и идём, пока не вернёмся к нему", cycle[0]),
+			fmt.Sprintf("Start a cycle at %d and follow it back", cycle[0]),
+			strings.Join(path, " → "),
+		)
+	}
+
+	trace.SetResult(formatCycles(cycles))
+	return cycles, trace
+}
+
+// Возвращает композицию перестановок и объяснение: куда переходит каждый
+// аргумент сначала под действием второй, а затем первой перестановки.
+//
+// Возвращает ошибку, если длины перестановок не равны.
+func (p1 Permutation) MultiplyExplained(p2 Permutation) (*Permutation, *explain.Trace, error) {
+	product, err := p1.Multiply(p2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trace := explain.NewTrace("Умножение перестановок", "Permutation multiplication")
+	for i := 0; i < p2.size; i++ {
+		arg := p2.arguments[i]
+		val := p2.associations[arg]
+		trace.Add(
+			fmt.Sprintf("Аргумент %d", arg),
+			fmt.Sprintf("Argument %d", arg),
+			fmt.Sprintf("%d → %d → %d", arg, val, p1.associations[val]),
+		)
+	}
+
+	trace.SetResult(fmt.Sprintf("%v / %v", product.arguments, product.values))
+	return product, trace, nil
+}
+
+// Записывает циклы в виде (1 3 8)(2 9).
+func formatCycles(cycles [][]int) string {
+	if len(cycles) == 0 {
+		return "()"
+	}
+	var builder strings.Builder
+	for _, cycle := range cycles {
+		elements := make([]string, len(cycle))
+		for i, element := range cycle {
+			elements[i] = fmt.Sprint(element)
+		}
+		fmt.Fprintf(&builder, "(%s)", strings.Join(elements, " "))
+	}
+	return builder.String()
+}
@@ -0,0 +1,88 @@
+package permutations
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/wadrodrog/math-helper/lib/explain"
+)
+
+// Should list every inversion pair
+func TestPermutationInversionsExplained(t *testing.T) {
+	permutation, err := NewSequencePermutation(5, []int{2, 3, 5, 1, 4})
+	if err != nil {
+		t.Fatalf("got an error while initializing Permutation: %v", err)
+	}
+
+	got, trace := permutation.InversionsExplained()
+	if got != 4 {
+		t.Errorf("got %d, want 4", got)
+	}
+
+	want := "Inversions count\n" +
+		"1. Permutation: [2 3 5 1 4]\n" +
+		"2. Inversion at positions (1, 4): 2 > 1\n" +
+		"3. Inversion at positions (2, 4): 3 > 1\n" +
+		"4. Inversion at positions (3, 4): 5 > 1\n" +
+		"5. Inversion at positions (3, 5): 5 > 4\n" +
+		"Result: 4\n"
+	if text := trace.Text(explain.English); text != want {
+		t.Errorf("got %q, want %q", text, want)
+	}
+}
+
+// Should explain how every cycle is built
+func TestPermutationCyclesExplained(t *testing.T) {
+	permutation, err := NewSequencePermutation(5, []int{4, 5, 3, 1, 2})
+	if err != nil {
+		t.Fatalf("got an error while initializing Permutation: %v", err)
+	}
+
+	got, trace := permutation.CyclesExplained()
+	if fmt.Sprintf("%v", got) != "[[1 4] [2 5]]" {
+		t.Errorf("got %v, want [[1 4] [2 5]]", got)
+	}
+
+	want := "### Разложение на циклы\n\n" +
+		"1. Элемент 3 остаётся на месте, цикл длины 1 не записывается: `3 → 3`\n" +
+		"2. Начинаем цикл с 1 и идём, пока не вернёмся к нему: `1 → 4 → 1`\n" +
+		"3. Начинаем цикл с 2 и идём, пока не вернёмся к нему: `2 → 5 → 2`\n" +
+		"\n**Ответ:** `(1 4)(2 5)`\n"
+	if markdown := trace.Markdown(explain.Russian); markdown != want {
+		t.Errorf("got %q, want %q", markdown, want)
+	}
+}
+
+// Should explain where every argument goes
+func TestPermutationsMultiplyExplained(t *testing.T) {
+	permutation1, err := NewSequencePermutation(3, []int{2, 3, 1})
+	if err != nil {
+		t.Fatalf("got an error while initializing Permutation 1: %v", err)
+	}
+	permutation2, err := NewSequencePermutation(3, []int{2, 1, 3})
+	if err != nil {
+		t.Fatalf("got an error while initializing Permutation 2: %v", err)
+	}
+
+	got, trace, err := permutation1.MultiplyExplained(*permutation2)
+	if err != nil {
+		t.Fatalf("got an error while multiplying permutations: %v", err)
+	}
+	if fmt.Sprintf("%v", got.values) != "[3 2 1]" {
+		t.Errorf("got %v, want [3 2 1]", got.values)
+	}
+
+	want := "Permutation multiplication\n" +
+		"1. Argument 1: 1 → 2 → 3\n" +
+		"2. Argument 2: 2 → 1 → 2\n" +
+		"3. Argument 3: 3 → 3 → 1\n" +
+		"Result: [1 2 3] / [3 2 1]\n"
+	if text := trace.Text(explain.English); text != want {
+		t.Errorf("got %q, want %q", text, want)
+	}
+
+	permutation3, _ := NewSequencePermutation(2, []int{2, 1})
+	if _, _, err := permutation1.MultiplyExplained(*permutation3); err == nil {
+		t.Errorf("no error %q", InvalidLengthError(2, 3))
+	}
+}
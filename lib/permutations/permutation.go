@@ -7,6 +7,7 @@
 //   - Разложение на транспозиции
 //   - Сборка перестановки из транспозиции
 //   - Умножение перестановок
+//   - Пошаговые объяснения подсчёта инверсий, разложения на циклы и умножения
 package permutations
 
 func allNumbersFrom1ToN(n int, slice []int) error {
@@ -126,6 +127,11 @@ func NewSequencePermutationFromTranspositions(transpositions [][]int) (*Permutat
 	return NewPermutation(maxValue, arguments, values)
 }
 
+// Возвращает значение перестановки для заданного аргумента.
+func (p *Permutation) Value(argument int) int {
+	return p.associations[argument]
+}
+
 // Возвращает количество инверсий перестановки.
 func (p *Permutation) Inversions() int {
 	// Возващаем кэшированное значение
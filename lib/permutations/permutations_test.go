@@ -366,3 +366,18 @@ func TestPermutationsMultiply(t *testing.T) {
 		})
 	}
 }
+
+// Should return value for argument
+func TestPermutationValue(t *testing.T) {
+	permutation, err := NewPermutation(5, []int{3, 1, 2, 5, 4}, []int{5, 4, 3, 2, 1})
+	if err != nil {
+		t.Fatalf("got an error while initializing Permutation: %v", err)
+	}
+
+	want := map[int]int{1: 4, 2: 3, 3: 5, 4: 1, 5: 2}
+	for argument, value := range want {
+		if got := permutation.Value(argument); got != value {
+			t.Errorf("got %d, want %d for argument %d", got, value, argument)
+		}
+	}
+}